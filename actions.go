@@ -0,0 +1,316 @@
+package sumsub
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// PaymentMethodType type of the payment method verified by the applicant action.
+type PaymentMethodType string
+
+const (
+	PaymentMethodTypeBankCard     PaymentMethodType = "bankCard"
+	PaymentMethodTypeBankAccount  PaymentMethodType = "bankAccount"
+	PaymentMethodTypeCryptoWallet PaymentMethodType = "cryptoWallet"
+	PaymentMethodTypeEWallet      PaymentMethodType = "eWallet"
+)
+
+type (
+	CreateApplicantActionRequest struct {
+		ApplicantID      string
+		LevelName        string
+		ExternalActionID string
+		PaymentMethod    PaymentMethod
+	}
+
+	PaymentMethod struct {
+		Type              PaymentMethodType
+		AccountIdentifier string // masked card number, IBAN or wallet address
+		IssuingCountry    Country
+	}
+
+	ApplicantActionResponse struct {
+		ID               string
+		ApplicantID      string
+		ExternalActionID string
+		LevelName        string
+		CreatedAt        time.Time
+		PaymentMethod    PaymentMethod
+		RequiredIDDocs   RequiredIDDocs
+		Review           Review
+	}
+
+	AddApplicantActionImageRequest struct {
+		ActionID  string
+		IDDocType IDDocType
+		Country   Country
+		FileName  string
+		Content   io.Reader
+	}
+
+	AddApplicantActionImageResponse struct {
+		IDDocType IDDocType
+		Country   Country
+	}
+
+	RequestApplicantActionCheckRequest struct {
+		ActionID string
+	}
+
+	ApplicantActionDataRequest struct {
+		ActionID string
+	}
+
+	ApplicantActionsRequest struct {
+		ApplicantID string
+		Offset      int
+		Limit       int
+	}
+
+	ApplicantActionsResponse struct {
+		Items      []ApplicantActionResponse
+		TotalItems int
+	}
+)
+
+type (
+	reqCreateApplicantAction struct {
+		ExternalActionID string             `json:"externalActionId"`
+		PaymentMethod    *respPaymentMethod `json:"paymentMethod,omitempty"`
+	}
+
+	respPaymentMethod struct {
+		Type              PaymentMethodType `json:"type"`
+		AccountIdentifier string            `json:"accountIdentifier,omitempty"`
+		IssuingCountry    Country           `json:"issuingCountry,omitempty"`
+	}
+
+	respApplicantAction struct {
+		ID               string             `json:"id"`
+		ApplicantID      string             `json:"applicantId"`
+		ExternalActionID string             `json:"externalActionId"`
+		LevelName        string             `json:"levelName"`
+		CreatedAt        Time               `json:"createdAt"`
+		PaymentMethod    respPaymentMethod  `json:"paymentMethod"`
+		RequiredIDDocs   respRequiredIDDocs `json:"requiredIdDocs"`
+		Review           respReview         `json:"review"`
+	}
+
+	reqAddApplicantActionImageMetadata struct {
+		IDDocType IDDocType `json:"idDocType"`
+		Country   Country   `json:"country,omitempty"`
+	}
+
+	respAddApplicantActionImage struct {
+		IDDocType IDDocType `json:"idDocType"`
+		Country   Country   `json:"country"`
+	}
+
+	reqRequestApplicantActionCheck struct {
+	}
+
+	respOK struct {
+		OK int `json:"ok"`
+	}
+
+	reqApplicantActionData struct {
+	}
+
+	reqApplicantActions struct {
+	}
+
+	respApplicantActions struct {
+		Items      []respApplicantAction `json:"items"`
+		TotalItems int                   `json:"totalItems"`
+	}
+)
+
+func (r respApplicantAction) model() ApplicantActionResponse {
+	return ApplicantActionResponse{
+		ID:               r.ID,
+		ApplicantID:      r.ApplicantID,
+		ExternalActionID: r.ExternalActionID,
+		LevelName:        r.LevelName,
+		CreatedAt:        r.CreatedAt.Time,
+		PaymentMethod:    PaymentMethod(r.PaymentMethod),
+		RequiredIDDocs:   RequiredIDDocs{DocSets: mapSlice(r.RequiredIDDocs.DocSets, respDocSet.model)},
+		Review:           r.Review.model(),
+	}
+}
+
+// CreateApplicantAction Use this method to create an applicant action (e.g. payment method verification) for the level.
+// https://docs.sumsub.com/reference/create-applicant-action
+func (c *Client) CreateApplicantAction(ctx context.Context, req CreateApplicantActionRequest) (ApplicantActionResponse, error) {
+	var pm *respPaymentMethod
+	if req.PaymentMethod.Type != "" {
+		if req.PaymentMethod.IssuingCountry != "" {
+			if err := req.PaymentMethod.IssuingCountry.Validate(); err != nil {
+				return ApplicantActionResponse{}, fmt.Errorf("payment method: issuing country: %w", err)
+			}
+		}
+		v := respPaymentMethod(req.PaymentMethod)
+		pm = &v
+	}
+
+	resp, err := call[reqCreateApplicantAction, respApplicantAction](ctx, c,
+		http.MethodPost,
+		(&url.URL{
+			Path:     fmt.Sprintf("/resources/applicantActions/-/forApplicant/%s", url.PathEscape(req.ApplicantID)),
+			RawQuery: url.Values{"levelName": {req.LevelName}}.Encode(),
+		}).String(),
+		reqCreateApplicantAction{
+			ExternalActionID: req.ExternalActionID,
+			PaymentMethod:    pm,
+		},
+	)
+	if err != nil {
+		return ApplicantActionResponse{}, fmt.Errorf("call: %w", err)
+	}
+
+	return resp.model(), nil
+}
+
+// AddApplicantActionImage Use this method to upload an image (e.g. photo of the bank card) to the applicant action.
+// https://docs.sumsub.com/reference/add-image-to-applicant-action
+func (c *Client) AddApplicantActionImage(ctx context.Context, req AddApplicantActionImageRequest) (AddApplicantActionImageResponse, error) {
+	if err := req.IDDocType.Validate(); err != nil {
+		return AddApplicantActionImageResponse{}, err
+	}
+	if req.Country != "" {
+		if err := req.Country.Validate(); err != nil {
+			return AddApplicantActionImageResponse{}, err
+		}
+	}
+	if req.Content == nil {
+		return AddApplicantActionImageResponse{}, errors.New("empty content")
+	}
+
+	contentType, payload, err := multipartPayload(
+		reqAddApplicantActionImageMetadata{
+			IDDocType: req.IDDocType,
+			Country:   req.Country,
+		},
+		req.FileName,
+		req.Content,
+	)
+	if err != nil {
+		return AddApplicantActionImageResponse{}, fmt.Errorf("multipart: %w", err)
+	}
+
+	resp, err := callRaw[respAddApplicantActionImage](ctx, c,
+		http.MethodPost,
+		fmt.Sprintf("/resources/applicantActions/%s/images", url.PathEscape(req.ActionID)),
+		contentType,
+		payload,
+	)
+	if err != nil {
+		return AddApplicantActionImageResponse{}, fmt.Errorf("call: %w", err)
+	}
+
+	return AddApplicantActionImageResponse{
+		IDDocType: resp.IDDocType,
+		Country:   resp.Country,
+	}, nil
+}
+
+// RequestApplicantActionCheck Use this method to request the check of the applicant action after all images are uploaded.
+// https://docs.sumsub.com/reference/request-applicant-action-check
+func (c *Client) RequestApplicantActionCheck(ctx context.Context, req RequestApplicantActionCheckRequest) error {
+	_, err := call[reqRequestApplicantActionCheck, respOK](ctx, c,
+		http.MethodPost,
+		fmt.Sprintf("/resources/applicantActions/%s/review/requested", url.PathEscape(req.ActionID)),
+		reqRequestApplicantActionCheck{},
+	)
+	if err != nil {
+		return fmt.Errorf("call: %w", err)
+	}
+	return nil
+}
+
+// ApplicantActionData Use this method to get the applicant action status and review result.
+// https://docs.sumsub.com/reference/get-applicant-action-data
+func (c *Client) ApplicantActionData(ctx context.Context, req ApplicantActionDataRequest) (ApplicantActionResponse, error) {
+	resp, err := call[reqApplicantActionData, respApplicantAction](ctx, c,
+		http.MethodGet,
+		fmt.Sprintf("/resources/applicantActions/%s/one", url.PathEscape(req.ActionID)),
+		reqApplicantActionData{},
+	)
+	if err != nil {
+		return ApplicantActionResponse{}, fmt.Errorf("call: %w", err)
+	}
+
+	return resp.model(), nil
+}
+
+// ApplicantActions Use this method to list the actions of the applicant.
+// https://docs.sumsub.com/reference/get-applicant-actions
+func (c *Client) ApplicantActions(ctx context.Context, req ApplicantActionsRequest) (ApplicantActionsResponse, error) {
+	q := url.Values{}
+	if req.Offset > 0 {
+		q.Set("offset", fmt.Sprintf("%d", req.Offset))
+	}
+	if req.Limit > 0 {
+		q.Set("limit", fmt.Sprintf("%d", req.Limit))
+	}
+
+	resp, err := call[reqApplicantActions, respApplicantActions](ctx, c,
+		http.MethodGet,
+		(&url.URL{
+			Path:     fmt.Sprintf("/resources/applicantActions/-;applicantId=%s", url.PathEscape(req.ApplicantID)),
+			RawQuery: q.Encode(),
+		}).String(),
+		reqApplicantActions{},
+	)
+	if err != nil {
+		return ApplicantActionsResponse{}, fmt.Errorf("call: %w", err)
+	}
+
+	return ApplicantActionsResponse{
+		Items:      mapSlice(resp.Items, respApplicantAction.model),
+		TotalItems: resp.TotalItems,
+	}, nil
+}
+
+// ApplicantActionsPages returns the paginator over all actions of the applicant.
+func (c *Client) ApplicantActionsPages(applicantID string, opts ...PaginatorOpt) *Paginator[ApplicantActionResponse] {
+	return NewPaginator(func(ctx context.Context, offset, limit int) (Page[ApplicantActionResponse], error) {
+		resp, err := c.ApplicantActions(ctx, ApplicantActionsRequest{ApplicantID: applicantID, Offset: offset, Limit: limit})
+		return Page[ApplicantActionResponse](resp), err
+	}, opts...)
+}
+
+// multipartPayload builds the multipart form with JSON `metadata` and `content` file parts as SumSub expects for uploads.
+func multipartPayload(metadata any, fileName string, content io.Reader) (string, []byte, error) {
+	meta, err := json.Marshal(metadata)
+	if err != nil {
+		return "", nil, fmt.Errorf("marshal metadata: %w", err)
+	}
+	if fileName == "" {
+		fileName = "file"
+	}
+
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	if err = w.WriteField("metadata", string(meta)); err != nil {
+		return "", nil, fmt.Errorf("metadata: %w", err)
+	}
+	fw, err := w.CreateFormFile("content", fileName)
+	if err != nil {
+		return "", nil, fmt.Errorf("content: %w", err)
+	}
+	if _, err = io.Copy(fw, content); err != nil {
+		return "", nil, fmt.Errorf("content: %w", err)
+	}
+	if err = w.Close(); err != nil {
+		return "", nil, fmt.Errorf("close: %w", err)
+	}
+	return w.FormDataContentType(), buf.Bytes(), nil
+}
@@ -0,0 +1,153 @@
+package sumsub
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testApplicantAction = `{
+  "id": "63e096ba2c2d4e3b0d5ba8a0",
+  "applicantId": "63e096972c2d4e3b0d5ba87f",
+  "externalActionId": "txn-1",
+  "levelName": "payment-method",
+  "createdAt": "2023-02-06 06:29:46",
+  "paymentMethod": {"type": "bankCard", "accountIdentifier": "4111********1111", "issuingCountry": "DEU"},
+  "requiredIdDocs": {"docSets": [{"idDocSetType": "PAYMENT_METHODS", "types": ["BANK_CARD"]}]},
+  "review": {"reviewStatus": "completed", "reviewResult": {"reviewAnswer": "GREEN"}}
+}`
+
+func TestCreateApplicantAction(t *testing.T) {
+	cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/resources/applicantActions/-/forApplicant/63e096972c2d4e3b0d5ba87f", r.URL.Path)
+		assert.Equal(t, "payment-method", r.URL.Query().Get("levelName"))
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"externalActionId":"txn-1","paymentMethod":{"type":"bankCard","accountIdentifier":"4111********1111","issuingCountry":"DEU"}}`, string(body))
+		_, _ = w.Write([]byte(testApplicantAction))
+	})
+
+	resp, err := cli.CreateApplicantAction(context.Background(), CreateApplicantActionRequest{
+		ApplicantID:      "63e096972c2d4e3b0d5ba87f",
+		LevelName:        "payment-method",
+		ExternalActionID: "txn-1",
+		PaymentMethod: PaymentMethod{
+			Type:              PaymentMethodTypeBankCard,
+			AccountIdentifier: "4111********1111",
+			IssuingCountry:    "DEU",
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ApplicantActionResponse{
+		ID:               "63e096ba2c2d4e3b0d5ba8a0",
+		ApplicantID:      "63e096972c2d4e3b0d5ba87f",
+		ExternalActionID: "txn-1",
+		LevelName:        "payment-method",
+		CreatedAt:        time.Date(2023, 2, 6, 6, 29, 46, 0, time.UTC),
+		PaymentMethod: PaymentMethod{
+			Type:              PaymentMethodTypeBankCard,
+			AccountIdentifier: "4111********1111",
+			IssuingCountry:    "DEU",
+		},
+		RequiredIDDocs: RequiredIDDocs{DocSets: DocSets{{IDDocSetType: "PAYMENT_METHODS", Types: []IDDocType{IDDocTypeBankCard}}}},
+		Review: Review{
+			ReviewStatus: ReviewStatusCompleted,
+			ReviewResult: ReviewResult{ReviewAnswer: ReviewAnswerGreen},
+		},
+	}, resp)
+}
+
+func TestAddApplicantActionImage(t *testing.T) {
+	cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/resources/applicantActions/63e096ba2c2d4e3b0d5ba8a0/images", r.URL.Path)
+		if !assert.NoError(t, r.ParseMultipartForm(1<<20)) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var meta map[string]string
+		assert.NoError(t, json.Unmarshal([]byte(r.FormValue("metadata")), &meta))
+		assert.Equal(t, map[string]string{"idDocType": "BANK_CARD", "country": "DEU"}, meta)
+		f, fh, err := r.FormFile("content")
+		if !assert.NoError(t, err) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		content, _ := io.ReadAll(f)
+		assert.Equal(t, "card.jpg", fh.Filename)
+		assert.Equal(t, "image", string(content))
+		_, _ = w.Write([]byte(`{"idDocType":"BANK_CARD","country":"DEU"}`))
+	})
+
+	resp, err := cli.AddApplicantActionImage(context.Background(), AddApplicantActionImageRequest{
+		ActionID:  "63e096ba2c2d4e3b0d5ba8a0",
+		IDDocType: IDDocTypeBankCard,
+		Country:   "DEU",
+		FileName:  "card.jpg",
+		Content:   strings.NewReader("image"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, AddApplicantActionImageResponse{IDDocType: IDDocTypeBankCard, Country: "DEU"}, resp)
+
+	_, err = cli.AddApplicantActionImage(context.Background(), AddApplicantActionImageRequest{IDDocType: "CARD"})
+	assert.EqualError(t, err, "invalid id doc type: CARD")
+}
+
+func TestApplicantActionCheckAndData(t *testing.T) {
+	cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/resources/applicantActions/63e096ba2c2d4e3b0d5ba8a0/review/requested":
+			assert.Equal(t, http.MethodPost, r.Method)
+			_, _ = w.Write([]byte(`{"ok":1}`))
+		case "/resources/applicantActions/63e096ba2c2d4e3b0d5ba8a0/one":
+			assert.Equal(t, http.MethodGet, r.Method)
+			_, _ = w.Write([]byte(testApplicantAction))
+		case "/resources/applicantActions/-;applicantId=63e096972c2d4e3b0d5ba87f":
+			assert.Equal(t, "10", r.URL.Query().Get("limit"))
+			_, _ = w.Write([]byte(`{"items":[` + testApplicantAction + `],"totalItems":1}`))
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	})
+
+	require.NoError(t, cli.RequestApplicantActionCheck(context.Background(), RequestApplicantActionCheckRequest{ActionID: "63e096ba2c2d4e3b0d5ba8a0"}))
+
+	action, err := cli.ApplicantActionData(context.Background(), ApplicantActionDataRequest{ActionID: "63e096ba2c2d4e3b0d5ba8a0"})
+	require.NoError(t, err)
+	assert.True(t, action.Review.ReviewResult.IsApproved())
+
+	list, err := cli.ApplicantActions(context.Background(), ApplicantActionsRequest{ApplicantID: "63e096972c2d4e3b0d5ba87f", Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, 1, list.TotalItems)
+	assert.Equal(t, []ApplicantActionResponse{action}, list.Items)
+}
+
+func TestParseApplicantActionWebhook(t *testing.T) {
+	wh, err := ParseApplicantActionWebhook([]byte(`{
+  "applicantId": "63e096972c2d4e3b0d5ba87f",
+  "applicantActionId": "63e096ba2c2d4e3b0d5ba8a0",
+  "externalApplicantActionId": "txn-1",
+  "inspectionId": "63e096972c2d4e3b0d5ba880",
+  "correlationId": "req-7a5ba2cc-4b5e-4e6e-9d8c-3f3e3c3b3a39",
+  "levelName": "payment-method",
+  "externalUserId": "user-1",
+  "type": "applicantActionReviewed",
+  "reviewResult": {"reviewAnswer": "RED", "rejectLabels": ["FORGERY"], "reviewRejectType": "FINAL"},
+  "reviewStatus": "completed",
+  "createdAtMs": "2023-02-06 06:31:02.123"
+}`))
+	require.NoError(t, err)
+	assert.Equal(t, WebhookTypeApplicantActionReviewed, wh.Type)
+	assert.Equal(t, "63e096ba2c2d4e3b0d5ba8a0", wh.ApplicantActionID)
+	assert.Equal(t, "txn-1", wh.ExternalApplicantActionID)
+	assert.True(t, wh.ReviewResult.IsFinalRejected())
+
+	_, err = ParseApplicantActionWebhook([]byte(`{"type":"applicantReviewed"}`))
+	assert.EqualError(t, err, "not an applicant action webhook: applicantReviewed")
+}
@@ -0,0 +1,219 @@
+package sumsub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// AMLMatchType type of the screening list the hit was found in.
+type AMLMatchType string
+
+const (
+	AMLMatchTypeSanction       AMLMatchType = "sanction"
+	AMLMatchTypePEP            AMLMatchType = "pep"
+	AMLMatchTypeAdverseMedia   AMLMatchType = "adverse-media"
+	AMLMatchTypeWarning        AMLMatchType = "warning"
+	AMLMatchTypeFitnessProbity AMLMatchType = "fitness-probity"
+)
+
+const checkTypeAML = "AML"
+
+type (
+	RecheckAMLRequest struct {
+		ApplicantID string
+	}
+
+	LatestAMLCheckRequest struct {
+		ApplicantID string
+	}
+
+	// AMLCheck result of the sanctions, PEP and adverse media screening.
+	AMLCheck struct {
+		ID        string
+		CreatedAt time.Time
+		Status    string
+		Answer    ReviewAnswer
+		Hits      []AMLHit
+	}
+
+	AMLHit struct {
+		ID         string
+		Name       string
+		MatchScore float64 // 0..100
+		MatchTypes []AMLMatchType
+		Lists      []AMLList
+		Countries  []Country
+		DOB        string // as listed, could be a year only
+		Aliases    []string
+	}
+
+	AMLList struct {
+		Name   string
+		Source string
+		URL    string
+	}
+
+	// AMLLabeledWebhook applicantOnHold or applicantReviewed webhook with AML related reject labels in Labels.
+	AMLLabeledWebhook struct {
+		Webhook
+		Labels []RejectLabel
+	}
+)
+
+type (
+	reqRecheckAML struct {
+	}
+
+	reqLatestChecks struct {
+	}
+
+	respLatestChecks struct {
+		Items []respAMLCheck `json:"items"`
+	}
+
+	respAMLCheck struct {
+		ID        string       `json:"id"`
+		CreatedAt Time         `json:"createdAt"`
+		CheckType string       `json:"checkType"`
+		Status    string       `json:"status"`
+		Answer    ReviewAnswer `json:"answer"`
+		Hits      []respAMLHit `json:"hits"`
+	}
+
+	respAMLHit struct {
+		ID         string         `json:"id"`
+		Name       string         `json:"name"`
+		MatchScore float64        `json:"matchScore"`
+		MatchTypes []AMLMatchType `json:"matchTypes"`
+		Lists      []respAMLList  `json:"lists"`
+		Countries  []Country      `json:"countries"`
+		DOB        string         `json:"dob"`
+		Aliases    []string       `json:"aliases"`
+	}
+
+	respAMLList struct {
+		Name   string `json:"name"`
+		Source string `json:"source"`
+		URL    string `json:"url"`
+	}
+)
+
+var amlRejectLabels = map[RejectLabel]struct{}{
+	RejectLabelSanctions:          {},
+	RejectLabelPEP:                {},
+	RejectLabelAdverseMedia:       {},
+	RejectLabelCriminal:           {},
+	RejectLabelCompromisedPersons: {},
+}
+
+func (r respAMLCheck) model() AMLCheck {
+	return AMLCheck{
+		ID:        r.ID,
+		CreatedAt: r.CreatedAt.Time,
+		Status:    r.Status,
+		Answer:    r.Answer,
+		Hits:      mapSlice(r.Hits, respAMLHit.model),
+	}
+}
+
+func (r respAMLHit) model() AMLHit {
+	return AMLHit{
+		ID:         r.ID,
+		Name:       r.Name,
+		MatchScore: r.MatchScore,
+		MatchTypes: r.MatchTypes,
+		Lists:      mapSlice(r.Lists, func(l respAMLList) AMLList { return AMLList(l) }),
+		Countries:  r.Countries,
+		DOB:        r.DOB,
+		Aliases:    r.Aliases,
+	}
+}
+
+// RecheckAML Use this method to re-run the AML screening of the applicant, e.g. after the name change.
+// https://docs.sumsub.com/reference/re-run-aml-check
+func (c *Client) RecheckAML(ctx context.Context, req RecheckAMLRequest) error {
+	_, err := call[reqRecheckAML, json.RawMessage](ctx, c,
+		http.MethodPost,
+		fmt.Sprintf("/resources/applicants/%s/recheck/aml", url.PathEscape(req.ApplicantID)),
+		reqRecheckAML{},
+	)
+	if err != nil {
+		return fmt.Errorf("call: %w", err)
+	}
+	return nil
+}
+
+// LatestAMLCheck Use this method to get the latest AML screening result of the applicant.
+// Returns zero AMLCheck and false if the applicant was not screened yet.
+// https://docs.sumsub.com/reference/get-latest-checks
+func (c *Client) LatestAMLCheck(ctx context.Context, req LatestAMLCheckRequest) (AMLCheck, bool, error) {
+	resp, err := call[reqLatestChecks, respLatestChecks](ctx, c,
+		http.MethodGet,
+		(&url.URL{
+			Path: "/resources/checks/latest",
+			RawQuery: url.Values{
+				"applicantId": {req.ApplicantID},
+				"type":        {checkTypeAML},
+			}.Encode(),
+		}).String(),
+		reqLatestChecks{},
+	)
+	if err != nil {
+		return AMLCheck{}, false, fmt.Errorf("call: %w", err)
+	}
+	for _, item := range resp.Items {
+		if item.CheckType == checkTypeAML {
+			return item.model(), true, nil
+		}
+	}
+	return AMLCheck{}, false, nil
+}
+
+// HasMatchType reports whether the hit was found in the list of the type.
+func (h AMLHit) HasMatchType(t AMLMatchType) bool {
+	for _, v := range h.MatchTypes {
+		if v == t {
+			return true
+		}
+	}
+	return false
+}
+
+// HitsAbove returns hits with the match score greater or equal to the threshold.
+func (c AMLCheck) HitsAbove(score float64) []AMLHit {
+	var res []AMLHit
+	for _, h := range c.Hits {
+		if h.MatchScore >= score {
+			res = append(res, h)
+		}
+	}
+	return res
+}
+
+// ParseAMLLabeledWebhook decodes the webhook and reports whether it is applicantOnHold or applicantReviewed
+// with AML reject labels (sanctions, PEP, adverse media, etc.). It is a label filter, not the ongoing monitoring
+// event model: the payload does not tell the onboarding screening from the monitoring hit, compare with
+// the previously known state of the applicant (e.g. LatestAMLCheck) if needed.
+func ParseAMLLabeledWebhook(payload []byte) (AMLLabeledWebhook, bool, error) {
+	wh, err := ParseWebhook(payload)
+	if err != nil {
+		return AMLLabeledWebhook{}, false, err
+	}
+	if wh.Type != WebhookTypeApplicantOnHold && wh.Type != WebhookTypeApplicantReviewed {
+		return AMLLabeledWebhook{}, false, nil
+	}
+	var labels []RejectLabel
+	for _, l := range wh.ReviewResult.RejectLabels {
+		if _, ok := amlRejectLabels[l]; ok {
+			labels = append(labels, l)
+		}
+	}
+	if len(labels) == 0 {
+		return AMLLabeledWebhook{}, false, nil
+	}
+	return AMLLabeledWebhook{Webhook: wh, Labels: labels}, true, nil
+}
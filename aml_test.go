@@ -0,0 +1,77 @@
+package sumsub
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecheckAML(t *testing.T) {
+	cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/resources/applicants/5b594ade0a975a36c9349e66/recheck/aml", r.URL.Path)
+		_, _ = w.Write([]byte(`{"ok":1}`))
+	})
+	require.NoError(t, cli.RecheckAML(context.Background(), RecheckAMLRequest{ApplicantID: "5b594ade0a975a36c9349e66"}))
+}
+
+func TestLatestAMLCheck(t *testing.T) {
+	cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/resources/checks/latest", r.URL.Path)
+		assert.Equal(t, "AML", r.URL.Query().Get("type"))
+		switch r.URL.Query().Get("applicantId") {
+		case "5b594ade0a975a36c9349e66":
+			_, _ = w.Write([]byte(`{"items":[{"id":"untyped","status":"completed"},{"id":"63e0a2b6","checkType":"AML","createdAt":"2023-02-06 07:20:54","status":"completed","answer":"RED","hits":[
+				{"id":"h1","name":"John Smith","matchScore":97.5,"matchTypes":["sanction","pep"],"lists":[{"name":"OFAC SDN","source":"ofac","url":"https://sanctionssearch.ofac.treas.gov"}],"countries":["USA"],"dob":"1970","aliases":["J. Smith"]},
+				{"id":"h2","name":"Jon Smit","matchScore":61,"matchTypes":["adverse-media"]}
+			]}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"items":[]}`))
+		}
+	})
+
+	check, ok, err := cli.LatestAMLCheck(context.Background(), LatestAMLCheckRequest{ApplicantID: "5b594ade0a975a36c9349e66"})
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "63e0a2b6", check.ID)
+	assert.Equal(t, time.Date(2023, 2, 6, 7, 20, 54, 0, time.UTC), check.CreatedAt)
+	assert.Equal(t, ReviewAnswerRed, check.Answer)
+	require.Len(t, check.Hits, 2)
+	assert.Equal(t, AMLHit{
+		ID:         "h1",
+		Name:       "John Smith",
+		MatchScore: 97.5,
+		MatchTypes: []AMLMatchType{AMLMatchTypeSanction, AMLMatchTypePEP},
+		Lists:      []AMLList{{Name: "OFAC SDN", Source: "ofac", URL: "https://sanctionssearch.ofac.treas.gov"}},
+		Countries:  []Country{"USA"},
+		DOB:        "1970",
+		Aliases:    []string{"J. Smith"},
+	}, check.Hits[0])
+	assert.True(t, check.Hits[0].HasMatchType(AMLMatchTypePEP))
+	assert.False(t, check.Hits[1].HasMatchType(AMLMatchTypeSanction))
+	assert.Len(t, check.HitsAbove(90), 1)
+
+	_, ok, err = cli.LatestAMLCheck(context.Background(), LatestAMLCheckRequest{ApplicantID: "other"})
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestParseAMLLabeledWebhook(t *testing.T) {
+	wh, ok, err := ParseAMLLabeledWebhook([]byte(`{"applicantId":"5b594ade0a975a36c9349e66","type":"applicantOnHold","reviewStatus":"onHold","reviewResult":{"reviewAnswer":"RED","rejectLabels":["SANCTIONS","PEP"]}}`))
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "5b594ade0a975a36c9349e66", wh.ApplicantID)
+	assert.Equal(t, []RejectLabel{RejectLabelSanctions, RejectLabelPEP}, wh.Labels)
+
+	_, ok, err = ParseAMLLabeledWebhook([]byte(`{"applicantId":"5b594ade0a975a36c9349e66","type":"applicantReviewed","reviewStatus":"completed","reviewResult":{"reviewAnswer":"RED","rejectLabels":["FORGERY"]}}`))
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	_, _, err = ParseAMLLabeledWebhook([]byte(`{`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,485 @@
+package sumsub
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type (
+	// FixedInfo applicant data provided by the client, it has priority over the data extracted from documents.
+	FixedInfo struct {
+		FirstName      string
+		FirstNameEn    string
+		MiddleName     string
+		MiddleNameEn   string
+		LastName       string
+		LastNameEn     string
+		LegalName      string
+		Gender         string
+		DOB            time.Time
+		PlaceOfBirth   string
+		PlaceOfBirthEn string
+		StateOfBirth   string
+		CountryOfBirth Country
+		Nationality    Country
+		Country        Country
+		Phone          string
+		TIN            string
+		Addresses      []Address
+	}
+
+	// Info applicant data extracted from the documents.
+	Info struct {
+		FirstName      string
+		FirstNameEn    string
+		MiddleName     string
+		MiddleNameEn   string
+		LastName       string
+		LastNameEn     string
+		LegalName      string
+		Gender         string
+		DOB            time.Time
+		PlaceOfBirth   string
+		PlaceOfBirthEn string
+		StateOfBirth   string
+		CountryOfBirth Country
+		Nationality    Country
+		Country        Country
+		Phone          string
+		TIN            string
+		Addresses      []Address
+		IDDocs         []IDDoc
+	}
+
+	Address struct {
+		Country          Country
+		PostCode         string
+		Town             string
+		TownEn           string
+		Street           string
+		StreetEn         string
+		SubStreet        string
+		SubStreetEn      string
+		State            string
+		StateEn          string
+		BuildingName     string
+		FlatNumber       string
+		BuildingNumber   string
+		FormattedAddress string
+	}
+
+	IDDoc struct {
+		IDDocType      IDDocType
+		Country        Country
+		FirstName      string
+		FirstNameEn    string
+		MiddleName     string
+		MiddleNameEn   string
+		LastName       string
+		LastNameEn     string
+		IssuedDate     time.Time
+		ValidUntil     time.Time
+		IssueAuthority string
+		Number         string
+		DOB            time.Time
+		PlaceOfBirth   string
+		Gender         string
+		MRZLine1       string
+		MRZLine2       string
+		MRZLine3       string
+	}
+
+	Agreement struct {
+		CreatedAt  time.Time
+		AcceptedAt time.Time
+		Source     string
+		Targets    []string
+		RecordIDs  []string
+	}
+
+	Review struct {
+		ReviewID            string
+		AttemptID           string
+		AttemptCnt          int
+		ElapsedSincePending time.Duration
+		ElapsedSinceQueued  time.Duration
+		Reprocessing        bool
+		LevelName           string
+		LevelAutoCheckMode  string
+		CreateDate          time.Time
+		ReviewDate          time.Time
+		ReviewResult        ReviewResult
+		ReviewStatus        ReviewStatus
+		Priority            int
+	}
+
+	RequiredIDDocs struct {
+		DocSets DocSets
+	}
+
+	DocSets []DocSet
+
+	DocSet struct {
+		IDDocSetType  string
+		Types         []IDDocType
+		SubTypes      []string
+		VideoRequired string
+	}
+
+	Metadata struct {
+		Key   string
+		Value string
+	}
+)
+
+type (
+	respApplicantData struct {
+		ID                string              `json:"id"`
+		CreatedAt         Time                `json:"createdAt"`
+		CreatedBy         string              `json:"createdBy"`
+		Key               string              `json:"key"`
+		ClientID          string              `json:"clientId"`
+		InspectionID      string              `json:"inspectionId"`
+		ExternalUserID    string              `json:"externalUserId"`
+		SourceKey         string              `json:"sourceKey"`
+		FixedInfo         respApplicantInfo   `json:"fixedInfo"`
+		Info              respApplicantInfo   `json:"info"`
+		Email             string              `json:"email"`
+		Phone             string              `json:"phone"`
+		ApplicantPlatform string              `json:"applicantPlatform"`
+		IPCountry         Country             `json:"ipCountry"`
+		AuthCode          string              `json:"authCode"`
+		Agreement         respAgreement       `json:"agreement"`
+		RequiredIDDocs    respRequiredIDDocs  `json:"requiredIdDocs"`
+		Review            respReview          `json:"review"`
+		Lang              string              `json:"lang"`
+		Type              string              `json:"type"`
+		Tags              []string            `json:"tags"`
+		Metadata          []respMetadata      `json:"metadata"`
+		Questionnaires    []respQuestionnaire `json:"questionnaires"`
+		Raw               json.RawMessage     `json:"-"`
+	}
+
+	respApplicantInfo struct {
+		FirstName      string        `json:"firstName"`
+		FirstNameEn    string        `json:"firstNameEn"`
+		MiddleName     string        `json:"middleName"`
+		MiddleNameEn   string        `json:"middleNameEn"`
+		LastName       string        `json:"lastName"`
+		LastNameEn     string        `json:"lastNameEn"`
+		LegalName      string        `json:"legalName"`
+		Gender         string        `json:"gender"`
+		DOB            Time          `json:"dob"`
+		PlaceOfBirth   string        `json:"placeOfBirth"`
+		PlaceOfBirthEn string        `json:"placeOfBirthEn"`
+		StateOfBirth   string        `json:"stateOfBirth"`
+		CountryOfBirth Country       `json:"countryOfBirth"`
+		Nationality    Country       `json:"nationality"`
+		Country        Country       `json:"country"`
+		Phone          string        `json:"phone"`
+		TIN            string        `json:"tin"`
+		Addresses      []respAddress `json:"addresses"`
+		IDDocs         []respIDDoc   `json:"idDocs"`
+	}
+
+	respAddress struct {
+		Country          Country `json:"country"`
+		PostCode         string  `json:"postCode"`
+		Town             string  `json:"town"`
+		TownEn           string  `json:"townEn"`
+		Street           string  `json:"street"`
+		StreetEn         string  `json:"streetEn"`
+		SubStreet        string  `json:"subStreet"`
+		SubStreetEn      string  `json:"subStreetEn"`
+		State            string  `json:"state"`
+		StateEn          string  `json:"stateEn"`
+		BuildingName     string  `json:"buildingName"`
+		FlatNumber       string  `json:"flatNumber"`
+		BuildingNumber   string  `json:"buildingNumber"`
+		FormattedAddress string  `json:"formattedAddress"`
+	}
+
+	respIDDoc struct {
+		IDDocType      IDDocType `json:"idDocType"`
+		Country        Country   `json:"country"`
+		FirstName      string    `json:"firstName"`
+		FirstNameEn    string    `json:"firstNameEn"`
+		MiddleName     string    `json:"middleName"`
+		MiddleNameEn   string    `json:"middleNameEn"`
+		LastName       string    `json:"lastName"`
+		LastNameEn     string    `json:"lastNameEn"`
+		IssuedDate     Time      `json:"issuedDate"`
+		ValidUntil     Time      `json:"validUntil"`
+		IssueAuthority string    `json:"issueAuthority"`
+		Number         string    `json:"number"`
+		DOB            Time      `json:"dob"`
+		PlaceOfBirth   string    `json:"placeOfBirth"`
+		Gender         string    `json:"gender"`
+		MRZLine1       string    `json:"mrzLine1"`
+		MRZLine2       string    `json:"mrzLine2"`
+		MRZLine3       string    `json:"mrzLine3"`
+	}
+
+	respAgreement struct {
+		CreatedAt  Time     `json:"createdAt"`
+		AcceptedAt Time     `json:"acceptedAt"`
+		Source     string   `json:"source"`
+		Targets    []string `json:"targets"`
+		RecordIDs  []string `json:"recordIds"`
+	}
+
+	respRequiredIDDocs struct {
+		DocSets []respDocSet `json:"docSets"`
+	}
+
+	respDocSet struct {
+		IDDocSetType  string      `json:"idDocSetType"`
+		Types         []IDDocType `json:"types"`
+		SubTypes      []string    `json:"subTypes"`
+		VideoRequired string      `json:"videoRequired"`
+	}
+
+	respReview struct {
+		ReviewID            string           `json:"reviewId"`
+		AttemptID           string           `json:"attemptId"`
+		AttemptCnt          int              `json:"attemptCnt"`
+		ElapsedSincePending int64            `json:"elapsedSincePendingMs"`
+		ElapsedSinceQueued  int64            `json:"elapsedSinceQueuedMs"`
+		Reprocessing        bool             `json:"reprocessing"`
+		LevelName           string           `json:"levelName"`
+		LevelAutoCheckMode  string           `json:"levelAutoCheckMode"`
+		CreateDate          Time             `json:"createDate"`
+		ReviewDate          Time             `json:"reviewDate"`
+		ReviewResult        respReviewResult `json:"reviewResult"`
+		ReviewStatus        ReviewStatus     `json:"reviewStatus"`
+		Priority            int              `json:"priority"`
+	}
+
+	respMetadata struct {
+		Key   string `json:"key"`
+		Value string `json:"value"`
+	}
+
+	reqFixedInfo struct {
+		FirstName      string       `json:"firstName"`
+		FirstNameEn    string       `json:"firstNameEn,omitempty"`
+		MiddleName     string       `json:"middleName,omitempty"`
+		MiddleNameEn   string       `json:"middleNameEn,omitempty"`
+		LastName       string       `json:"lastName"`
+		LastNameEn     string       `json:"lastNameEn,omitempty"`
+		LegalName      string       `json:"legalName,omitempty"`
+		Gender         string       `json:"gender,omitempty"`
+		DOB            string       `json:"dob,omitempty"`
+		PlaceOfBirth   string       `json:"placeOfBirth,omitempty"`
+		PlaceOfBirthEn string       `json:"placeOfBirthEn,omitempty"`
+		StateOfBirth   string       `json:"stateOfBirth,omitempty"`
+		CountryOfBirth Country      `json:"countryOfBirth,omitempty"`
+		Nationality    Country      `json:"nationality,omitempty"`
+		Country        Country      `json:"country,omitempty"`
+		Phone          string       `json:"phone,omitempty"`
+		TIN            string       `json:"tin,omitempty"`
+		Addresses      []reqAddress `json:"addresses,omitempty"`
+	}
+
+	reqAddress struct {
+		Country          Country `json:"country,omitempty"`
+		PostCode         string  `json:"postCode,omitempty"`
+		Town             string  `json:"town,omitempty"`
+		TownEn           string  `json:"townEn,omitempty"`
+		Street           string  `json:"street,omitempty"`
+		StreetEn         string  `json:"streetEn,omitempty"`
+		SubStreet        string  `json:"subStreet,omitempty"`
+		SubStreetEn      string  `json:"subStreetEn,omitempty"`
+		State            string  `json:"state,omitempty"`
+		StateEn          string  `json:"stateEn,omitempty"`
+		BuildingName     string  `json:"buildingName,omitempty"`
+		FlatNumber       string  `json:"flatNumber,omitempty"`
+		BuildingNumber   string  `json:"buildingNumber,omitempty"`
+		FormattedAddress string  `json:"formattedAddress,omitempty"`
+	}
+)
+
+// UnmarshalJSON keeps the raw response to preserve fields not covered by the model.
+func (r *respApplicantData) UnmarshalJSON(b []byte) error {
+	type plain respApplicantData
+	if err := json.Unmarshal(b, (*plain)(r)); err != nil {
+		return err
+	}
+	r.Raw = append(json.RawMessage(nil), b...)
+	return nil
+}
+
+func (r respApplicantData) model() ApplicantDataResponse {
+	return ApplicantDataResponse{
+		ID:                r.ID,
+		CreatedAt:         r.CreatedAt.Time,
+		CreatedBy:         r.CreatedBy,
+		Key:               r.Key,
+		ClientID:          r.ClientID,
+		InspectionID:      r.InspectionID,
+		ExternalUserID:    r.ExternalUserID,
+		SourceKey:         r.SourceKey,
+		FixedInfo:         r.FixedInfo.fixedInfo(),
+		Info:              r.Info.info(),
+		Email:             r.Email,
+		Phone:             r.Phone,
+		ApplicantPlatform: r.ApplicantPlatform,
+		IPCountry:         r.IPCountry,
+		AuthCode:          r.AuthCode,
+		Agreement:         r.Agreement.model(),
+		Review:            r.Review.model(),
+		RequiredIDDocs:    RequiredIDDocs{DocSets: mapSlice(r.RequiredIDDocs.DocSets, respDocSet.model)},
+		Lang:              r.Lang,
+		Type:              r.Type,
+		Tags:              r.Tags,
+		Metadata:          mapSlice(r.Metadata, respMetadata.model),
+		Questionnaires:    mapSlice(r.Questionnaires, respQuestionnaire.model),
+		Raw:               r.Raw,
+	}
+}
+
+func (r respApplicantInfo) fixedInfo() FixedInfo {
+	return FixedInfo{
+		FirstName:      r.FirstName,
+		FirstNameEn:    r.FirstNameEn,
+		MiddleName:     r.MiddleName,
+		MiddleNameEn:   r.MiddleNameEn,
+		LastName:       r.LastName,
+		LastNameEn:     r.LastNameEn,
+		LegalName:      r.LegalName,
+		Gender:         r.Gender,
+		DOB:            r.DOB.Time,
+		PlaceOfBirth:   r.PlaceOfBirth,
+		PlaceOfBirthEn: r.PlaceOfBirthEn,
+		StateOfBirth:   r.StateOfBirth,
+		CountryOfBirth: r.CountryOfBirth,
+		Nationality:    r.Nationality,
+		Country:        r.Country,
+		Phone:          r.Phone,
+		TIN:            r.TIN,
+		Addresses:      mapSlice(r.Addresses, respAddress.model),
+	}
+}
+
+func (r respApplicantInfo) info() Info {
+	f := r.fixedInfo()
+	return Info{
+		FirstName:      f.FirstName,
+		FirstNameEn:    f.FirstNameEn,
+		MiddleName:     f.MiddleName,
+		MiddleNameEn:   f.MiddleNameEn,
+		LastName:       f.LastName,
+		LastNameEn:     f.LastNameEn,
+		LegalName:      f.LegalName,
+		Gender:         f.Gender,
+		DOB:            f.DOB,
+		PlaceOfBirth:   f.PlaceOfBirth,
+		PlaceOfBirthEn: f.PlaceOfBirthEn,
+		StateOfBirth:   f.StateOfBirth,
+		CountryOfBirth: f.CountryOfBirth,
+		Nationality:    f.Nationality,
+		Country:        f.Country,
+		Phone:          f.Phone,
+		TIN:            f.TIN,
+		Addresses:      f.Addresses,
+		IDDocs:         mapSlice(r.IDDocs, respIDDoc.model),
+	}
+}
+
+func (r respAddress) model() Address {
+	return Address(r)
+}
+
+func (r respIDDoc) model() IDDoc {
+	return IDDoc{
+		IDDocType:      r.IDDocType,
+		Country:        r.Country,
+		FirstName:      r.FirstName,
+		FirstNameEn:    r.FirstNameEn,
+		MiddleName:     r.MiddleName,
+		MiddleNameEn:   r.MiddleNameEn,
+		LastName:       r.LastName,
+		LastNameEn:     r.LastNameEn,
+		IssuedDate:     r.IssuedDate.Time,
+		ValidUntil:     r.ValidUntil.Time,
+		IssueAuthority: r.IssueAuthority,
+		Number:         r.Number,
+		DOB:            r.DOB.Time,
+		PlaceOfBirth:   r.PlaceOfBirth,
+		Gender:         r.Gender,
+		MRZLine1:       r.MRZLine1,
+		MRZLine2:       r.MRZLine2,
+		MRZLine3:       r.MRZLine3,
+	}
+}
+
+func (r respAgreement) model() Agreement {
+	return Agreement{
+		CreatedAt:  r.CreatedAt.Time,
+		AcceptedAt: r.AcceptedAt.Time,
+		Source:     r.Source,
+		Targets:    r.Targets,
+		RecordIDs:  r.RecordIDs,
+	}
+}
+
+func (r respDocSet) model() DocSet {
+	return DocSet(r)
+}
+
+func (r respReview) model() Review {
+	return Review{
+		ReviewID:            r.ReviewID,
+		AttemptID:           r.AttemptID,
+		AttemptCnt:          r.AttemptCnt,
+		ElapsedSincePending: time.Duration(r.ElapsedSincePending) * time.Millisecond,
+		ElapsedSinceQueued:  time.Duration(r.ElapsedSinceQueued) * time.Millisecond,
+		Reprocessing:        r.Reprocessing,
+		LevelName:           r.LevelName,
+		LevelAutoCheckMode:  r.LevelAutoCheckMode,
+		CreateDate:          r.CreateDate.Time,
+		ReviewDate:          r.ReviewDate.Time,
+		ReviewResult:        r.ReviewResult.model(),
+		ReviewStatus:        r.ReviewStatus,
+		Priority:            r.Priority,
+	}
+}
+
+func (r respMetadata) model() Metadata {
+	return Metadata(r)
+}
+
+func newReqFixedInfo(f FixedInfo) reqFixedInfo {
+	return reqFixedInfo{
+		FirstName:      f.FirstName,
+		FirstNameEn:    f.FirstNameEn,
+		MiddleName:     f.MiddleName,
+		MiddleNameEn:   f.MiddleNameEn,
+		LastName:       f.LastName,
+		LastNameEn:     f.LastNameEn,
+		LegalName:      f.LegalName,
+		Gender:         f.Gender,
+		DOB:            requestTime(f.DOB, "2006-01-02"),
+		PlaceOfBirth:   f.PlaceOfBirth,
+		PlaceOfBirthEn: f.PlaceOfBirthEn,
+		StateOfBirth:   f.StateOfBirth,
+		CountryOfBirth: f.CountryOfBirth,
+		Nationality:    f.Nationality,
+		Country:        f.Country,
+		Phone:          f.Phone,
+		TIN:            f.TIN,
+		Addresses:      mapSlice(f.Addresses, func(a Address) reqAddress { return reqAddress(a) }),
+	}
+}
+
+// mapSlice converts transport models to public ones, nil stays nil.
+func mapSlice[T, M any](in []T, f func(T) M) []M {
+	if in == nil {
+		return nil
+	}
+	out := make([]M, 0, len(in))
+	for _, v := range in {
+		out = append(out, f(v))
+	}
+	return out
+}
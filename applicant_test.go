@@ -0,0 +1,90 @@
+package sumsub
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicantDataModel(t *testing.T) {
+	msg := []byte(`{
+  "id": "5b594ade0a975a36c9349e66",
+  "createdAt": "2020-06-24 05:05:14",
+  "clientId": "ClientName",
+  "externalUserId": "SomeExternalUserId",
+  "ipCountry": "DEU",
+  "fixedInfo": {
+    "firstName": "Chris",
+    "lastName": "Smith",
+    "dob": "1989-07-16",
+    "nationality": "DEU",
+    "addresses": [
+      {"country": "DEU", "postCode": "10115", "town": "Berlin", "street": "Invalidenstr. 1"}
+    ]
+  },
+  "info": {
+    "firstNameEn": "CHRISTIAN",
+    "idDocs": [
+      {"idDocType": "ID_CARD", "country": "DEU", "issuedDate": "2018-09-05", "validUntil": "2028-09-04", "number": "LGXX359T8"}
+    ]
+  },
+  "requiredIdDocs": {
+    "docSets": [
+      {"idDocSetType": "IDENTITY", "types": ["PASSPORT", "ID_CARD"], "videoRequired": "disabled"}
+    ]
+  },
+  "review": {
+    "elapsedSincePendingMs": 115879,
+    "levelName": "basic-kyc",
+    "reviewDate": "2020-06-24 05:12:58+0000",
+    "reviewResult": {"reviewAnswer": "GREEN"},
+    "reviewStatus": "completed"
+  },
+  "tags": ["VIP"],
+  "metadata": [{"key": "source", "value": "import"}],
+  "undocumentedField": {"nested": true}
+}`)
+
+	var resp respApplicantData
+	require.NoError(t, json.Unmarshal(msg, &resp))
+	m := resp.model()
+
+	assert.Equal(t, "5b594ade0a975a36c9349e66", m.ID)
+	assert.Equal(t, Country("DEU"), m.IPCountry)
+	assert.Equal(t, time.Date(1989, 7, 16, 0, 0, 0, 0, time.UTC), m.FixedInfo.DOB)
+	assert.Equal(t, Country("DEU"), m.FixedInfo.Nationality)
+	assert.Equal(t, []Address{{Country: "DEU", PostCode: "10115", Town: "Berlin", Street: "Invalidenstr. 1"}}, m.FixedInfo.Addresses)
+	require.Len(t, m.Info.IDDocs, 1)
+	assert.Equal(t, IDDocTypeIDCard, m.Info.IDDocs[0].IDDocType)
+	assert.Equal(t, time.Date(2018, 9, 5, 0, 0, 0, 0, time.UTC), m.Info.IDDocs[0].IssuedDate)
+	assert.Equal(t, DocSets{{IDDocSetType: "IDENTITY", Types: []IDDocType{IDDocTypePassport, IDDocTypeIDCard}, VideoRequired: "disabled"}}, m.RequiredIDDocs.DocSets)
+	assert.Equal(t, 115879*time.Millisecond, m.Review.ElapsedSincePending)
+	assert.Equal(t, ReviewAnswerGreen, m.Review.ReviewResult.ReviewAnswer)
+	assert.Equal(t, ReviewStatusCompleted, m.Review.ReviewStatus)
+	assert.Equal(t, []string{"VIP"}, m.Tags)
+	assert.Equal(t, []Metadata{{Key: "source", Value: "import"}}, m.Metadata)
+	assert.Nil(t, m.Info.Addresses)
+
+	var raw map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(m.Raw, &raw))
+	assert.JSONEq(t, `{"nested": true}`, string(raw["undocumentedField"]))
+}
+
+func TestReqFixedInfo(t *testing.T) {
+	b, err := json.Marshal(newReqFixedInfo(FixedInfo{
+		FirstName: "Chris",
+		LastName:  "Smith",
+		DOB:       time.Date(1989, 7, 16, 0, 0, 0, 0, time.UTC),
+		Addresses: []Address{{Country: "DEU", Town: "Berlin"}},
+	}))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+  "firstName": "Chris",
+  "lastName": "Smith",
+  "dob": "1989-07-16",
+  "addresses": [{"country": "DEU", "town": "Berlin"}]
+}`, string(b))
+}
@@ -0,0 +1,129 @@
+package sumsub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type (
+	BlocklistApplicantRequest struct {
+		ApplicantID string
+		Note        string
+	}
+
+	BlocklistApplicantResponse struct {
+		// AlreadyBlocklisted the applicant was blocklisted before, nothing changed.
+		AlreadyBlocklisted bool
+	}
+
+	AllowlistApplicantRequest struct {
+		ApplicantID string
+		Note        string
+	}
+
+	AllowlistApplicantResponse struct {
+		// AlreadyAllowlisted the applicant was allowlisted before, nothing changed.
+		AlreadyAllowlisted bool
+	}
+
+	ChangeApplicantPresenceRequest struct {
+		ApplicantID string
+	}
+
+	ChangeApplicantPresenceResponse struct {
+		// AlreadyInState the applicant was in the requested state before, nothing changed.
+		AlreadyInState bool
+	}
+
+	DeleteApplicantRequest struct {
+		ApplicantID string
+	}
+)
+
+type (
+	reqApplicantState struct {
+	}
+)
+
+// BlocklistApplicant Use this method to add the applicant to the blocklist with the note.
+// Blocklisting of the already blocklisted applicant is not an error, see BlocklistApplicantResponse.AlreadyBlocklisted.
+// https://docs.sumsub.com/reference/add-applicant-to-blocklist
+func (c *Client) BlocklistApplicant(ctx context.Context, req BlocklistApplicantRequest) (BlocklistApplicantResponse, error) {
+	already, err := c.changeApplicantState(ctx, http.MethodPost, applicantListURI(req.ApplicantID, "blacklist", req.Note), ErrCodeApplicantAlreadyBlacklisted)
+	if err != nil {
+		return BlocklistApplicantResponse{}, err
+	}
+	return BlocklistApplicantResponse{AlreadyBlocklisted: already}, nil
+}
+
+// AllowlistApplicant Use this method to add the applicant to the allowlist with the note.
+// Allowlisting of the already allowlisted applicant is not an error, see AllowlistApplicantResponse.AlreadyAllowlisted.
+// https://docs.sumsub.com/reference/add-applicant-to-allowlist
+func (c *Client) AllowlistApplicant(ctx context.Context, req AllowlistApplicantRequest) (AllowlistApplicantResponse, error) {
+	already, err := c.changeApplicantState(ctx, http.MethodPost, applicantListURI(req.ApplicantID, "whitelist", req.Note), ErrCodeApplicantAlreadyWhitelisted)
+	if err != nil {
+		return AllowlistApplicantResponse{}, err
+	}
+	return AllowlistApplicantResponse{AlreadyAllowlisted: already}, nil
+}
+
+// ActivateApplicant Use this method to activate the previously deactivated applicant.
+// https://docs.sumsub.com/reference/activate-applicant
+func (c *Client) ActivateApplicant(ctx context.Context, req ChangeApplicantPresenceRequest) (ChangeApplicantPresenceResponse, error) {
+	return c.changeApplicantPresence(ctx, req.ApplicantID, "activated")
+}
+
+// DeactivateApplicant Use this method to deactivate the applicant, no actions are allowed for deactivated applicants.
+// https://docs.sumsub.com/reference/deactivate-applicant
+func (c *Client) DeactivateApplicant(ctx context.Context, req ChangeApplicantPresenceRequest) (ChangeApplicantPresenceResponse, error) {
+	return c.changeApplicantPresence(ctx, req.ApplicantID, "deactivated")
+}
+
+// DeleteApplicant Use this method to mark the applicant as deleted.
+// ErrCodeApplicantMarkedAsDeleted is returned as is, SumSub uses it for both deleted and deactivated applicants.
+// https://docs.sumsub.com/reference/delete-applicant
+func (c *Client) DeleteApplicant(ctx context.Context, req DeleteApplicantRequest) error {
+	_, err := call[reqApplicantState, json.RawMessage](ctx, c,
+		http.MethodDelete,
+		fmt.Sprintf("/resources/applicants/%s", url.PathEscape(req.ApplicantID)),
+		reqApplicantState{},
+	)
+	if err != nil {
+		return fmt.Errorf("call: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) changeApplicantPresence(ctx context.Context, applicantID, presence string) (ChangeApplicantPresenceResponse, error) {
+	already, err := c.changeApplicantState(ctx,
+		http.MethodPatch,
+		fmt.Sprintf("/resources/applicants/%s/presence/%s", url.PathEscape(applicantID), presence),
+		ErrCodeApplicantAlreadyInTheState,
+	)
+	if err != nil {
+		return ChangeApplicantPresenceResponse{}, err
+	}
+	return ChangeApplicantPresenceResponse{AlreadyInState: already}, nil
+}
+
+// changeApplicantState calls the state changing endpoint, API error with alreadyCode is reported as already=true.
+func (c *Client) changeApplicantState(ctx context.Context, method, uri string, alreadyCode int) (bool, error) {
+	_, err := call[reqApplicantState, json.RawMessage](ctx, c, method, uri, reqApplicantState{})
+	if err != nil {
+		if e, ok := AsAPIError(err); ok && e.ErrorCode == alreadyCode {
+			return true, nil
+		}
+		return false, fmt.Errorf("call: %w", err)
+	}
+	return false, nil
+}
+
+func applicantListURI(applicantID, list, note string) string {
+	return (&url.URL{
+		Path:     fmt.Sprintf("/resources/applicants/%s/%s", url.PathEscape(applicantID), list),
+		RawQuery: url.Values{"note": {note}}.Encode(),
+	}).String()
+}
@@ -0,0 +1,83 @@
+package sumsub
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlocklistAllowlistApplicant(t *testing.T) {
+	blocked := false
+	cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		switch r.URL.Path {
+		case "/resources/applicants/5b594ade0a975a36c9349e66/blacklist":
+			assert.Equal(t, "chargeback fraud", r.URL.Query().Get("note"))
+			if blocked {
+				writeAPIError(w, http.StatusConflict, ErrCodeApplicantAlreadyBlacklisted)
+				return
+			}
+			blocked = true
+			_, _ = w.Write([]byte(`{"id":"5b594ade0a975a36c9349e66"}`))
+		case "/resources/applicants/5b594ade0a975a36c9349e66/whitelist":
+			writeAPIError(w, http.StatusConflict, ErrCodeApplicantAlreadyWhitelisted)
+		default:
+			writeAPIError(w, http.StatusNotFound, 0)
+		}
+	})
+
+	req := BlocklistApplicantRequest{ApplicantID: "5b594ade0a975a36c9349e66", Note: "chargeback fraud"}
+	resp, err := cli.BlocklistApplicant(context.Background(), req)
+	require.NoError(t, err)
+	assert.False(t, resp.AlreadyBlocklisted)
+
+	resp, err = cli.BlocklistApplicant(context.Background(), req)
+	require.NoError(t, err)
+	assert.True(t, resp.AlreadyBlocklisted)
+
+	allow, err := cli.AllowlistApplicant(context.Background(), AllowlistApplicantRequest{ApplicantID: "5b594ade0a975a36c9349e66"})
+	require.NoError(t, err)
+	assert.True(t, allow.AlreadyAllowlisted)
+
+	_, err = cli.BlocklistApplicant(context.Background(), BlocklistApplicantRequest{ApplicantID: "unknown"})
+	e, ok := AsAPIError(err)
+	require.True(t, ok)
+	assert.Equal(t, http.StatusNotFound, e.Code)
+}
+
+func TestApplicantPresenceAndDelete(t *testing.T) {
+	cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPatch && r.URL.Path == "/resources/applicants/5b594ade0a975a36c9349e66/presence/deactivated":
+			_, _ = w.Write([]byte(`{"ok":1}`))
+		case r.Method == http.MethodPatch && r.URL.Path == "/resources/applicants/5b594ade0a975a36c9349e66/presence/activated":
+			writeAPIError(w, http.StatusConflict, ErrCodeApplicantAlreadyInTheState)
+		case r.Method == http.MethodDelete && r.URL.Path == "/resources/applicants/5b594ade0a975a36c9349e66":
+			_, _ = w.Write([]byte(`{"ok":1}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/resources/applicants/deactivated":
+			writeAPIError(w, http.StatusConflict, ErrCodeApplicantMarkedAsDeleted)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	req := ChangeApplicantPresenceRequest{ApplicantID: "5b594ade0a975a36c9349e66"}
+	resp, err := cli.DeactivateApplicant(context.Background(), req)
+	require.NoError(t, err)
+	assert.False(t, resp.AlreadyInState)
+
+	resp, err = cli.ActivateApplicant(context.Background(), req)
+	require.NoError(t, err)
+	assert.True(t, resp.AlreadyInState)
+
+	require.NoError(t, cli.DeleteApplicant(context.Background(), DeleteApplicantRequest{ApplicantID: "5b594ade0a975a36c9349e66"}))
+
+	// marked as deleted or inactive is ambiguous, so it is not reported as success
+	err = cli.DeleteApplicant(context.Background(), DeleteApplicantRequest{ApplicantID: "deactivated"})
+	e, ok := AsAPIError(err)
+	require.True(t, ok)
+	assert.Equal(t, ErrCodeApplicantMarkedAsDeleted, e.ErrorCode)
+}
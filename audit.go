@@ -0,0 +1,172 @@
+package sumsub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+type (
+	// AuditTrailRequest all filters are optional.
+	AuditTrailRequest struct {
+		ApplicantID string
+		EventType   string
+		From        time.Time // inclusive
+		To          time.Time // exclusive
+		Offset      int
+		Limit       int
+	}
+
+	AuditTrailResponse struct {
+		Items      []AuditEvent
+		TotalItems int
+	}
+
+	AuditEvent struct {
+		ID          string
+		ApplicantID string
+		EventType   string
+		CreatedAt   time.Time
+		Actor       string // dashboard user or API token that made the change
+		ActorType   string
+		IP          string
+		Payload     json.RawMessage // event specific details as is
+	}
+
+	// AuditTrailIterator walks all audit trail pages, not safe for concurrent use.
+	AuditTrailIterator struct {
+		p *Paginator[AuditEvent]
+	}
+)
+
+type (
+	reqAuditTrail struct {
+	}
+
+	respAuditTrail struct {
+		Items      []respAuditEvent `json:"items"`
+		TotalItems int              `json:"totalItems"`
+	}
+
+	respAuditEvent struct {
+		ID          string          `json:"id"`
+		ApplicantID string          `json:"applicantId"`
+		EventType   string          `json:"eventType"`
+		CreatedAt   Time            `json:"createdAt"`
+		Actor       string          `json:"actor"`
+		ActorType   string          `json:"actorType"`
+		IP          string          `json:"ip"`
+		Payload     json.RawMessage `json:"payload"`
+	}
+
+	// jsonAuditEvent JSON Lines representation of AuditEvent.
+	jsonAuditEvent struct {
+		ID          string          `json:"id"`
+		ApplicantID string          `json:"applicantId,omitempty"`
+		EventType   string          `json:"eventType"`
+		CreatedAt   time.Time       `json:"createdAt"`
+		Actor       string          `json:"actor,omitempty"`
+		ActorType   string          `json:"actorType,omitempty"`
+		IP          string          `json:"ip,omitempty"`
+		Payload     json.RawMessage `json:"payload,omitempty"`
+	}
+)
+
+func (r respAuditEvent) model() AuditEvent {
+	return AuditEvent{
+		ID:          r.ID,
+		ApplicantID: r.ApplicantID,
+		EventType:   r.EventType,
+		CreatedAt:   r.CreatedAt.Time,
+		Actor:       r.Actor,
+		ActorType:   r.ActorType,
+		IP:          r.IP,
+		Payload:     r.Payload,
+	}
+}
+
+// AuditTrail Use this method to get a page of the audit trail events, see AuditTrailIterator to walk all pages.
+// https://docs.sumsub.com/reference/get-audit-trail-events
+func (c *Client) AuditTrail(ctx context.Context, req AuditTrailRequest) (AuditTrailResponse, error) {
+	q := url.Values{}
+	if req.ApplicantID != "" {
+		q.Set("applicantId", req.ApplicantID)
+	}
+	if req.EventType != "" {
+		q.Set("eventType", req.EventType)
+	}
+	if !req.From.IsZero() {
+		q.Set("createdAtFrom", requestTime(req.From.UTC(), timeLayoutDateTime))
+	}
+	if !req.To.IsZero() {
+		q.Set("createdAtTo", requestTime(req.To.UTC(), timeLayoutDateTime))
+	}
+	if req.Offset > 0 {
+		q.Set("offset", fmt.Sprintf("%d", req.Offset))
+	}
+	if req.Limit > 0 {
+		q.Set("limit", fmt.Sprintf("%d", req.Limit))
+	}
+
+	resp, err := call[reqAuditTrail, respAuditTrail](ctx, c,
+		http.MethodGet,
+		(&url.URL{
+			Path:     "/resources/auditTrailEvents/",
+			RawQuery: q.Encode(),
+		}).String(),
+		reqAuditTrail{},
+	)
+	if err != nil {
+		return AuditTrailResponse{}, fmt.Errorf("call: %w", err)
+	}
+
+	return AuditTrailResponse{
+		Items:      mapSlice(resp.Items, respAuditEvent.model),
+		TotalItems: resp.TotalItems,
+	}, nil
+}
+
+// AuditTrailEvents returns the iterator over all events matching the request filters,
+// req.Offset and req.Limit are used as start offset and page size unless overridden by opts.
+func (c *Client) AuditTrailEvents(req AuditTrailRequest, opts ...PaginatorOpt) *AuditTrailIterator {
+	return &AuditTrailIterator{p: NewPaginator(func(ctx context.Context, offset, limit int) (Page[AuditEvent], error) {
+		r := req
+		r.Offset, r.Limit = offset, limit
+		resp, err := c.AuditTrail(ctx, r)
+		return Page[AuditEvent](resp), err
+	}, append([]PaginatorOpt{WithOffset(req.Offset), WithPageSize(req.Limit)}, opts...)...)}
+}
+
+// Next returns the next event, io.EOF when all events are read.
+func (it *AuditTrailIterator) Next(ctx context.Context) (AuditEvent, error) {
+	return it.p.Next(ctx)
+}
+
+// TotalItems total number of events as reported by the last fetched page.
+func (it *AuditTrailIterator) TotalItems() int {
+	return it.p.TotalItems()
+}
+
+// WriteAuditTrailJSONL writes all events matching the request to w as JSON Lines, returns the number of written events.
+func (c *Client) WriteAuditTrailJSONL(ctx context.Context, w io.Writer, req AuditTrailRequest) (int, error) {
+	enc := json.NewEncoder(w)
+	it := c.AuditTrailEvents(req)
+	n := 0
+	for {
+		ev, err := it.Next(ctx)
+		if err == io.EOF {
+			return n, nil
+		}
+		if err != nil {
+			return n, err
+		}
+		if err = enc.Encode(jsonAuditEvent(ev)); err != nil {
+			return n, fmt.Errorf("encode: %w", err)
+		}
+		n++
+	}
+}
@@ -0,0 +1,80 @@
+package sumsub
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func auditTrailHandler(t *testing.T, total int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/resources/auditTrailEvents/", r.URL.Path)
+		q := r.URL.Query()
+		assert.Equal(t, "5b594ade0a975a36c9349e66", q.Get("applicantId"))
+		assert.Equal(t, "applicantReviewed", q.Get("eventType"))
+		assert.Equal(t, "2023-02-01 00:00:00", q.Get("createdAtFrom"))
+		assert.Equal(t, "2023-03-01 00:00:00", q.Get("createdAtTo"))
+		offset, _ := strconv.Atoi(q.Get("offset"))
+		limit, _ := strconv.Atoi(q.Get("limit"))
+		var items []string
+		for i := offset; i < total && i < offset+limit; i++ {
+			items = append(items, fmt.Sprintf(`{"id":"ev-%d","applicantId":"5b594ade0a975a36c9349e66","eventType":"applicantReviewed","createdAt":"2023-02-06 07:20:54","actor":"officer@example.com","payload":{"n":%d}}`, i, i))
+		}
+		_, _ = fmt.Fprintf(w, `{"items":[%s],"totalItems":%d}`, strings.Join(items, ","), total)
+	}
+}
+
+func auditTrailRequest() AuditTrailRequest {
+	return AuditTrailRequest{
+		ApplicantID: "5b594ade0a975a36c9349e66",
+		EventType:   "applicantReviewed",
+		From:        time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC),
+		To:          time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC),
+		Limit:       2,
+	}
+}
+
+func TestAuditTrailEvents(t *testing.T) {
+	cli := newTestClient(t, auditTrailHandler(t, 5))
+
+	it := cli.AuditTrailEvents(auditTrailRequest())
+	var ids []string
+	for {
+		ev, err := it.Next(context.Background())
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2023, 2, 6, 7, 20, 54, 0, time.UTC), ev.CreatedAt)
+		ids = append(ids, ev.ID)
+	}
+	assert.Equal(t, []string{"ev-0", "ev-1", "ev-2", "ev-3", "ev-4"}, ids)
+	assert.Equal(t, 5, it.TotalItems())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := cli.AuditTrailEvents(auditTrailRequest()).Next(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestWriteAuditTrailJSONL(t *testing.T) {
+	cli := newTestClient(t, auditTrailHandler(t, 3))
+
+	var buf bytes.Buffer
+	n, err := cli.WriteAuditTrailJSONL(context.Background(), &buf, auditTrailRequest())
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.JSONEq(t, `{"id":"ev-0","applicantId":"5b594ade0a975a36c9349e66","eventType":"applicantReviewed","createdAt":"2023-02-06T07:20:54Z","actor":"officer@example.com","payload":{"n":0}}`, lines[0])
+}
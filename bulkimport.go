@@ -0,0 +1,597 @@
+package sumsub
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ImportStatus outcome of the single imported row.
+type ImportStatus string
+
+const (
+	ImportStatusCreated  ImportStatus = "created"
+	ImportStatusExisting ImportStatus = "existing" // applicant already existed, resolved by external user id
+	ImportStatusSkipped  ImportStatus = "skipped"  // already done according to the checkpoint
+	ImportStatusFailed   ImportStatus = "failed"
+)
+
+type (
+	ImportRecord struct {
+		Row     int // 1-based, header is not counted
+		Request CreateApplicantRequest
+	}
+
+	// ImportRecordReader returns io.EOF when there are no more records.
+	// *ImportRowError marks the single invalid row, the import continues with the next one.
+	ImportRecordReader interface {
+		Read() (ImportRecord, error)
+	}
+
+	ImportRowError struct {
+		Row int
+		Err error
+	}
+
+	ImportResult struct {
+		Row            int
+		ExternalUserID string
+		ApplicantID    string
+		Status         ImportStatus
+		Err            error
+	}
+
+	BulkImportSummary struct {
+		Total    int
+		Created  int
+		Existing int
+		Skipped  int
+		Failed   int
+	}
+
+	// BulkImporter creates applicants from the records with a pool of workers.
+	// With the checkpoint file the interrupted import can be restarted, already imported rows are skipped.
+	BulkImporter struct {
+		cli           *Client
+		workers       int
+		interval      time.Duration
+		retries       int
+		retryInterval time.Duration
+		levelName     string
+		checkpoint    string
+		report        io.Writer
+	}
+
+	bulkImportOptions struct {
+		Workers        int
+		RatePerSecond  float64
+		Retries        int
+		RetryInterval  time.Duration
+		LevelName      string
+		CheckpointFile string
+		Report         io.Writer
+	}
+
+	// importThrottle pauses the dispatch of new rows while SumSub throttles or fails the requests.
+	importThrottle struct {
+		mu    sync.Mutex
+		until time.Time
+	}
+
+	BulkImportOpt func(*bulkImportOptions)
+
+	csvImportReader struct {
+		r       *csv.Reader
+		columns []string
+		row     int
+	}
+
+	jsonlImportReader struct {
+		s   *bufio.Scanner
+		row int
+	}
+
+	jsonImportResult struct {
+		Row            int          `json:"row"`
+		ExternalUserID string       `json:"externalUserId,omitempty"`
+		ApplicantID    string       `json:"applicantId,omitempty"`
+		Status         ImportStatus `json:"status"`
+		Error          string       `json:"error,omitempty"`
+	}
+)
+
+// importColumns supported CSV columns and JSON Lines keys.
+var importColumns = map[string]func(r *CreateApplicantRequest, v string) error{
+	"levelName":      func(r *CreateApplicantRequest, v string) error { r.LevelName = v; return nil },
+	"externalUserId": func(r *CreateApplicantRequest, v string) error { r.ExternalUserID = v; return nil },
+	"email":          func(r *CreateApplicantRequest, v string) error { r.Email = v; return nil },
+	"phone":          func(r *CreateApplicantRequest, v string) error { r.Phone = v; return nil },
+	"firstName":      func(r *CreateApplicantRequest, v string) error { r.FixedInfo.FirstName = v; return nil },
+	"firstNameEn":    func(r *CreateApplicantRequest, v string) error { r.FixedInfo.FirstNameEn = v; return nil },
+	"middleName":     func(r *CreateApplicantRequest, v string) error { r.FixedInfo.MiddleName = v; return nil },
+	"middleNameEn":   func(r *CreateApplicantRequest, v string) error { r.FixedInfo.MiddleNameEn = v; return nil },
+	"lastName":       func(r *CreateApplicantRequest, v string) error { r.FixedInfo.LastName = v; return nil },
+	"lastNameEn":     func(r *CreateApplicantRequest, v string) error { r.FixedInfo.LastNameEn = v; return nil },
+	"legalName":      func(r *CreateApplicantRequest, v string) error { r.FixedInfo.LegalName = v; return nil },
+	"gender":         func(r *CreateApplicantRequest, v string) error { r.FixedInfo.Gender = v; return nil },
+	"placeOfBirth":   func(r *CreateApplicantRequest, v string) error { r.FixedInfo.PlaceOfBirth = v; return nil },
+	"tin":            func(r *CreateApplicantRequest, v string) error { r.FixedInfo.TIN = v; return nil },
+	"dob": func(r *CreateApplicantRequest, v string) (err error) {
+		r.FixedInfo.DOB, err = time.Parse(timeLayoutDate, v)
+		return err
+	},
+	"countryOfBirth": func(r *CreateApplicantRequest, v string) (err error) {
+		r.FixedInfo.CountryOfBirth, err = ParseCountry(v)
+		return err
+	},
+	"nationality": func(r *CreateApplicantRequest, v string) (err error) {
+		r.FixedInfo.Nationality, err = ParseCountry(v)
+		return err
+	},
+	"country": func(r *CreateApplicantRequest, v string) (err error) {
+		r.FixedInfo.Country, err = ParseCountry(v)
+		return err
+	},
+}
+
+func (e *ImportRowError) Error() string {
+	return fmt.Sprintf("row %d: %s", e.Row, e.Err)
+}
+
+func (e *ImportRowError) Unwrap() error {
+	return e.Err
+}
+
+// NewCSVImportReader reads records from CSV with the header row, see importColumns for the column names.
+// Country columns accept alpha-2, alpha-3 or numeric codes, dob is YYYY-MM-DD.
+func NewCSVImportReader(r io.Reader) (ImportRecordReader, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+	for i, col := range header {
+		col = strings.TrimSpace(col)
+		if _, ok := importColumns[col]; !ok {
+			return nil, fmt.Errorf("unknown column: %s", col)
+		}
+		header[i] = col
+	}
+	return &csvImportReader{r: cr, columns: header}, nil
+}
+
+// NewJSONLImportReader reads records from JSON Lines, each line is a flat object with string values
+// named the same way as CSV columns.
+func NewJSONLImportReader(r io.Reader) ImportRecordReader {
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 64*1024), 1024*1024)
+	return &jsonlImportReader{s: s}
+}
+
+func (r *csvImportReader) Read() (ImportRecord, error) {
+	values, err := r.r.Read()
+	if err == io.EOF {
+		return ImportRecord{}, io.EOF
+	}
+	r.row++
+	if err != nil {
+		var pe *csv.ParseError
+		if errors.As(err, &pe) {
+			return ImportRecord{}, &ImportRowError{Row: r.row, Err: err}
+		}
+		return ImportRecord{}, fmt.Errorf("read: %w", err)
+	}
+	if len(values) != len(r.columns) {
+		return ImportRecord{}, &ImportRowError{Row: r.row, Err: fmt.Errorf("expected %d fields, got %d", len(r.columns), len(values))}
+	}
+	fields := make(map[string]string, len(values))
+	for i, v := range values {
+		fields[r.columns[i]] = v
+	}
+	return importRecord(r.row, fields)
+}
+
+func (r *jsonlImportReader) Read() (ImportRecord, error) {
+	for r.s.Scan() {
+		line := strings.TrimSpace(r.s.Text())
+		if line == "" {
+			continue
+		}
+		r.row++
+		var fields map[string]string
+		if err := json.Unmarshal([]byte(line), &fields); err != nil {
+			return ImportRecord{}, &ImportRowError{Row: r.row, Err: fmt.Errorf("unmarshal: %w", err)}
+		}
+		for k := range fields {
+			if _, ok := importColumns[k]; !ok {
+				return ImportRecord{}, &ImportRowError{Row: r.row, Err: fmt.Errorf("unknown field: %s", k)}
+			}
+		}
+		return importRecord(r.row, fields)
+	}
+	if err := r.s.Err(); err != nil {
+		return ImportRecord{}, fmt.Errorf("read: %w", err)
+	}
+	return ImportRecord{}, io.EOF
+}
+
+func importRecord(row int, fields map[string]string) (ImportRecord, error) {
+	rec := ImportRecord{Row: row}
+	for k, v := range fields {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+		if err := importColumns[k](&rec.Request, v); err != nil {
+			return ImportRecord{}, &ImportRowError{Row: row, Err: fmt.Errorf("%s: %w", k, err)}
+		}
+	}
+	return rec, nil
+}
+
+const maxImportRetryInterval = 30 * time.Second
+
+func NewBulkImporter(cli *Client, opts ...BulkImportOpt) *BulkImporter {
+	o := bulkImportOptions{
+		Workers:       4,
+		Retries:       5,
+		RetryInterval: time.Second,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.Workers <= 0 {
+		o.Workers = 1
+	}
+	if o.Retries < 0 {
+		o.Retries = 0
+	}
+	if o.RetryInterval <= 0 {
+		o.RetryInterval = time.Second
+	}
+	var interval time.Duration
+	if o.RatePerSecond > 0 {
+		interval = time.Duration(float64(time.Second) / o.RatePerSecond)
+	}
+	return &BulkImporter{
+		cli:           cli,
+		workers:       o.Workers,
+		interval:      interval,
+		retries:       o.Retries,
+		retryInterval: o.RetryInterval,
+		levelName:     o.LevelName,
+		checkpoint:    o.CheckpointFile,
+		report:        o.Report,
+	}
+}
+
+// WithImportWorkers number of parallel CreateApplicant calls.
+func WithImportWorkers(n int) BulkImportOpt {
+	return func(opts *bulkImportOptions) {
+		opts.Workers = n
+	}
+}
+
+// WithImportRate max CreateApplicant calls per second, unlimited if zero.
+func WithImportRate(perSecond float64) BulkImportOpt {
+	return func(opts *bulkImportOptions) {
+		opts.RatePerSecond = perSecond
+	}
+}
+
+// WithImportRetries max number of retries of the row failed with the transient network error, 429 or 5xx answer, 5 by default.
+// Rows still failing are reported as failed and retried on the next run.
+func WithImportRetries(n int) BulkImportOpt {
+	return func(opts *bulkImportOptions) {
+		opts.Retries = n
+	}
+}
+
+// WithImportRetryInterval initial interval before the retry, doubled on every attempt up to 30s, 1s by default.
+// New rows are not dispatched until the interval passes.
+func WithImportRetryInterval(d time.Duration) BulkImportOpt {
+	return func(opts *bulkImportOptions) {
+		opts.RetryInterval = d
+	}
+}
+
+// WithImportLevelName level for the records without levelName.
+func WithImportLevelName(name string) BulkImportOpt {
+	return func(opts *bulkImportOptions) {
+		opts.LevelName = name
+	}
+}
+
+// WithImportCheckpoint file with the keys of the imported rows, created if not exists.
+func WithImportCheckpoint(path string) BulkImportOpt {
+	return func(opts *bulkImportOptions) {
+		opts.CheckpointFile = path
+	}
+}
+
+// WithImportReport writes the result of each row to w as JSON Lines.
+func WithImportReport(w io.Writer) BulkImportOpt {
+	return func(opts *bulkImportOptions) {
+		opts.Report = w
+	}
+}
+
+// Run imports all records from r. It stops on the reader, report or checkpoint failure and on the context
+// cancellation: requests in flight are aborted and their rows are reported as failed, they are not written
+// to the checkpoint and are imported again on the next run.
+func (b *BulkImporter) Run(ctx context.Context, r ImportRecordReader) (BulkImportSummary, error) {
+	done, cp, err := b.openCheckpoint()
+	if err != nil {
+		return BulkImportSummary{}, err
+	}
+	if cp != nil {
+		defer cp.Close() //nolint: errcheck
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	jobs := make(chan ImportRecord)
+	results := make(chan ImportResult)
+	throttle := &importThrottle{}
+
+	var wg sync.WaitGroup
+	for i := 0; i < b.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for rec := range jobs {
+				results <- b.importRecord(ctx, throttle, rec)
+			}
+		}()
+	}
+
+	var readErr error
+	go func() {
+		defer func() {
+			close(jobs)
+			wg.Wait()
+			close(results)
+		}()
+		readErr = b.dispatch(ctx, r, done, throttle, jobs, results)
+	}()
+
+	var (
+		summary BulkImportSummary
+		runErr  error
+	)
+	for res := range results {
+		summary.add(res.Status)
+		if runErr != nil {
+			continue
+		}
+		if err = b.writeReport(res); err != nil {
+			runErr = err
+			cancel()
+			continue
+		}
+		if cp != nil && (res.Status == ImportStatusCreated || res.Status == ImportStatusExisting) {
+			if _, err = fmt.Fprintln(cp, importKey(res.Row, res.ExternalUserID)); err != nil {
+				runErr = fmt.Errorf("write checkpoint: %w", err)
+				cancel()
+			}
+		}
+	}
+	if runErr != nil {
+		return summary, runErr
+	}
+	if readErr != nil {
+		return summary, readErr
+	}
+	return summary, ctx.Err()
+}
+
+// dispatch reads records and feeds workers respecting the rate limit and the throttling,
+// skipped and invalid rows go directly to results.
+func (b *BulkImporter) dispatch(ctx context.Context, r ImportRecordReader, done map[string]struct{}, throttle *importThrottle, jobs chan<- ImportRecord, results chan<- ImportResult) error {
+	var tick <-chan time.Time
+	if b.interval > 0 {
+		t := time.NewTicker(b.interval)
+		defer t.Stop()
+		tick = t.C
+	}
+	first := true
+	for {
+		rec, err := r.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			var re *ImportRowError
+			if !errors.As(err, &re) {
+				return err
+			}
+			results <- ImportResult{Row: re.Row, Status: ImportStatusFailed, Err: re.Err}
+			continue
+		}
+		if _, ok := done[importKey(rec.Row, rec.Request.ExternalUserID)]; ok {
+			results <- ImportResult{Row: rec.Row, ExternalUserID: rec.Request.ExternalUserID, Status: ImportStatusSkipped}
+			continue
+		}
+		if tick != nil && !first {
+			select {
+			case <-tick:
+			case <-ctx.Done():
+				return nil
+			}
+		}
+		first = false
+		if !throttle.wait(ctx) {
+			return nil
+		}
+		select {
+		case jobs <- rec:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (b *BulkImporter) importRecord(ctx context.Context, throttle *importThrottle, rec ImportRecord) ImportResult {
+	req := rec.Request
+	if req.LevelName == "" {
+		req.LevelName = b.levelName
+	}
+	res := ImportResult{Row: rec.Row, ExternalUserID: req.ExternalUserID}
+
+	var created CreateApplicantResponse
+	err := b.retry(ctx, throttle, func() (err error) {
+		created, err = b.cli.CreateApplicant(ctx, req)
+		return err
+	})
+	if err == nil {
+		res.ApplicantID = created.ID
+		res.Status = ImportStatusCreated
+		return res
+	}
+	if e, ok := AsAPIError(err); !ok || e.Code != http.StatusConflict || req.ExternalUserID == "" {
+		res.Status = ImportStatusFailed
+		res.Err = err
+		return res
+	}
+
+	var existing ApplicantDataResponse
+	err = b.retry(ctx, throttle, func() (err error) {
+		existing, err = b.cli.ApplicantData(ctx, ApplicantDataRequest{ExternalUserID: req.ExternalUserID})
+		return err
+	})
+	if err != nil {
+		res.Status = ImportStatusFailed
+		res.Err = fmt.Errorf("resolve existing: %w", err)
+		return res
+	}
+	res.ApplicantID = existing.ID
+	res.Status = ImportStatusExisting
+	return res
+}
+
+// retry calls fn until it succeeds, fails with not retryable error or the retries are over.
+// The dispatch of new rows is paused for the backoff interval.
+func (b *BulkImporter) retry(ctx context.Context, throttle *importThrottle, fn func() error) error {
+	interval := b.retryInterval
+	for attempt := 0; ; attempt++ {
+		err := fn()
+		if err == nil || attempt >= b.retries || !isRetryable(err) {
+			return err
+		}
+		throttle.pause(interval)
+		t := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return err
+		case <-t.C:
+		}
+		interval *= 2
+		if interval > maxImportRetryInterval {
+			interval = maxImportRetryInterval
+		}
+	}
+}
+
+// pause delays the dispatch for at least d.
+func (t *importThrottle) pause(d time.Duration) {
+	until := time.Now().Add(d)
+	t.mu.Lock()
+	if until.After(t.until) {
+		t.until = until
+	}
+	t.mu.Unlock()
+}
+
+// wait blocks until the pause is over, false if ctx is done.
+func (t *importThrottle) wait(ctx context.Context) bool {
+	for {
+		t.mu.Lock()
+		d := time.Until(t.until)
+		t.mu.Unlock()
+		if d <= 0 {
+			return true
+		}
+		timer := time.NewTimer(d)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return false
+		case <-timer.C:
+		}
+	}
+}
+
+func (b *BulkImporter) openCheckpoint() (map[string]struct{}, *os.File, error) {
+	done := make(map[string]struct{})
+	if b.checkpoint == "" {
+		return done, nil, nil
+	}
+	f, err := os.OpenFile(b.checkpoint, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, nil, fmt.Errorf("open checkpoint: %w", err)
+	}
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		if key := strings.TrimSpace(s.Text()); key != "" {
+			done[key] = struct{}{}
+		}
+	}
+	if err = s.Err(); err != nil {
+		_ = f.Close()
+		return nil, nil, fmt.Errorf("read checkpoint: %w", err)
+	}
+	return done, f, nil
+}
+
+func (b *BulkImporter) writeReport(res ImportResult) error {
+	if b.report == nil {
+		return nil
+	}
+	r := jsonImportResult{
+		Row:            res.Row,
+		ExternalUserID: res.ExternalUserID,
+		ApplicantID:    res.ApplicantID,
+		Status:         res.Status,
+	}
+	if res.Err != nil {
+		r.Error = res.Err.Error()
+	}
+	if err := json.NewEncoder(b.report).Encode(r); err != nil {
+		return fmt.Errorf("write report: %w", err)
+	}
+	return nil
+}
+
+func (s *BulkImportSummary) add(status ImportStatus) {
+	s.Total++
+	switch status {
+	case ImportStatusCreated:
+		s.Created++
+	case ImportStatusExisting:
+		s.Existing++
+	case ImportStatusSkipped:
+		s.Skipped++
+	case ImportStatusFailed:
+		s.Failed++
+	}
+}
+
+// importKey checkpoint key of the row, external user id is stable across source re-exports unlike the row number.
+func importKey(row int, externalUserID string) string {
+	if externalUserID != "" {
+		return "externalUserId:" + externalUserID
+	}
+	return fmt.Sprintf("row:%d", row)
+}
@@ -0,0 +1,174 @@
+package sumsub
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSVImportReader(t *testing.T) {
+	r, err := NewCSVImportReader(strings.NewReader("externalUserId,firstName,lastName,dob,country,levelName\n" +
+		"user-1,John,Smith,1990-05-17,DE,basic-kyc\n" +
+		"user-2,Jane,Doe,17.05.1990,USA,\n"))
+	require.NoError(t, err)
+
+	rec, err := r.Read()
+	require.NoError(t, err)
+	assert.Equal(t, ImportRecord{
+		Row: 1,
+		Request: CreateApplicantRequest{
+			LevelName:      "basic-kyc",
+			ExternalUserID: "user-1",
+			FixedInfo: FixedInfo{
+				FirstName: "John",
+				LastName:  "Smith",
+				DOB:       time.Date(1990, 5, 17, 0, 0, 0, 0, time.UTC),
+				Country:   "DEU",
+			},
+		},
+	}, rec)
+
+	_, err = r.Read()
+	var re *ImportRowError
+	require.ErrorAs(t, err, &re)
+	assert.Equal(t, 2, re.Row)
+
+	_, err = r.Read()
+	assert.Equal(t, io.EOF, err)
+
+	_, err = NewCSVImportReader(strings.NewReader("externalUserId,unknown\n"))
+	assert.EqualError(t, err, "unknown column: unknown")
+}
+
+func TestJSONLImportReader(t *testing.T) {
+	r := NewJSONLImportReader(strings.NewReader(`{"externalUserId":"user-1","email":"john@example.com","nationality":"276"}` + "\n\n" +
+		`{"externalUserId":"user-2","unknown":"x"}` + "\n"))
+
+	rec, err := r.Read()
+	require.NoError(t, err)
+	assert.Equal(t, ImportRecord{
+		Row: 1,
+		Request: CreateApplicantRequest{
+			ExternalUserID: "user-1",
+			Email:          "john@example.com",
+			FixedInfo:      FixedInfo{Nationality: "DEU"},
+		},
+	}, rec)
+
+	_, err = r.Read()
+	assert.EqualError(t, err, "row 2: unknown field: unknown")
+
+	_, err = r.Read()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestBulkImporter(t *testing.T) {
+	var creates int32
+	cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/resources/applicants":
+			atomic.AddInt32(&creates, 1)
+			assert.Equal(t, "basic-kyc", r.URL.Query().Get("levelName"))
+			var body struct {
+				ExternalUserID string `json:"externalUserId"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			switch body.ExternalUserID {
+			case "user-2":
+				w.WriteHeader(http.StatusConflict)
+				_, _ = w.Write([]byte(`{"description":"Applicant with external user id 'user-2' already exists","code":409,"correlationId":"req-1"}`))
+			case "user-3":
+				writeAPIError(w, http.StatusBadRequest, 0)
+			default:
+				_, _ = w.Write([]byte(`{"id":"id-` + body.ExternalUserID + `"}`))
+			}
+		case r.Method == http.MethodGet && r.URL.Path == "/resources/applicants/-;externalUserId=user-2/one":
+			_, _ = w.Write([]byte(`{"id":"id-user-2","externalUserId":"user-2"}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	const source = "externalUserId,firstName\nuser-1,John\nuser-2,Jane\nuser-3,Bad\nuser-4,Ann,Extra\n"
+	checkpoint := filepath.Join(t.TempDir(), "import.checkpoint")
+
+	var report bytes.Buffer
+	r, err := NewCSVImportReader(strings.NewReader(source))
+	require.NoError(t, err)
+	summary, err := NewBulkImporter(cli,
+		WithImportWorkers(2),
+		WithImportRate(1000),
+		WithImportLevelName("basic-kyc"),
+		WithImportCheckpoint(checkpoint),
+		WithImportReport(&report),
+	).Run(context.Background(), r)
+	require.NoError(t, err)
+	assert.Equal(t, BulkImportSummary{Total: 4, Created: 1, Existing: 1, Failed: 2}, summary)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&creates))
+
+	results := make(map[int]jsonImportResult)
+	for _, line := range strings.Split(strings.TrimSpace(report.String()), "\n") {
+		var res jsonImportResult
+		require.NoError(t, json.Unmarshal([]byte(line), &res))
+		results[res.Row] = res
+	}
+	assert.Equal(t, jsonImportResult{Row: 1, ExternalUserID: "user-1", ApplicantID: "id-user-1", Status: ImportStatusCreated}, results[1])
+	assert.Equal(t, jsonImportResult{Row: 2, ExternalUserID: "user-2", ApplicantID: "id-user-2", Status: ImportStatusExisting}, results[2])
+	assert.Equal(t, ImportStatusFailed, results[3].Status)
+	assert.NotEmpty(t, results[3].Error)
+	assert.Equal(t, ImportStatusFailed, results[4].Status)
+
+	cp, err := os.ReadFile(checkpoint)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"externalUserId:user-1", "externalUserId:user-2"}, strings.Fields(string(cp)))
+
+	// resume: imported rows are skipped, failed rows are retried
+	r, err = NewCSVImportReader(strings.NewReader(source))
+	require.NoError(t, err)
+	summary, err = NewBulkImporter(cli, WithImportLevelName("basic-kyc"), WithImportCheckpoint(checkpoint)).Run(context.Background(), r)
+	require.NoError(t, err)
+	assert.Equal(t, BulkImportSummary{Total: 4, Skipped: 2, Failed: 2}, summary)
+	assert.Equal(t, int32(4), atomic.LoadInt32(&creates))
+}
+
+func TestBulkImporterRetry(t *testing.T) {
+	var creates int32
+	cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch atomic.AddInt32(&creates, 1) {
+		case 1:
+			writeAPIError(w, http.StatusTooManyRequests, 0)
+		case 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"description":"Service unavailable","code":503}`))
+		default:
+			_, _ = w.Write([]byte(`{"id":"id-user-1"}`))
+		}
+	})
+
+	r, err := NewCSVImportReader(strings.NewReader("externalUserId\nuser-1\n"))
+	require.NoError(t, err)
+	summary, err := NewBulkImporter(cli, WithImportRetryInterval(time.Millisecond)).Run(context.Background(), r)
+	require.NoError(t, err)
+	assert.Equal(t, BulkImportSummary{Total: 1, Created: 1}, summary)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&creates))
+
+	// retries are over
+	atomic.StoreInt32(&creates, 0)
+	r, err = NewCSVImportReader(strings.NewReader("externalUserId\nuser-1\n"))
+	require.NoError(t, err)
+	summary, err = NewBulkImporter(cli, WithImportRetries(1), WithImportRetryInterval(time.Millisecond)).Run(context.Background(), r)
+	require.NoError(t, err)
+	assert.Equal(t, BulkImportSummary{Total: 1, Failed: 1}, summary)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&creates))
+}
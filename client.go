@@ -13,7 +13,10 @@ import (
 	"time"
 )
 
-var _ error = (*APIError)(nil)
+var (
+	_ error = (*APIError)(nil)
+	_ error = (*StatusError)(nil)
+)
 
 const (
 	Host = "api.sumsub.com"
@@ -21,11 +24,13 @@ const (
 
 type (
 	Client struct {
-		host   string
-		token  string
-		signer Signer
-		now    NowFunc
-		cli    *http.Client
+		host    string
+		token   string
+		signer  Signer
+		now     NowFunc
+		cli     *http.Client
+		sandbox bool
+		creds   CredentialsProvider
 	}
 
 	Signer interface {
@@ -35,9 +40,11 @@ type (
 	NowFunc func() time.Time
 
 	options struct {
-		Host       string
-		HTTPClient *http.Client
-		NowFunc    NowFunc
+		Host        string
+		HTTPClient  *http.Client
+		NowFunc     NowFunc
+		Sandbox     bool
+		Credentials CredentialsProvider
 	}
 
 	Opt func(*options)
@@ -45,32 +52,38 @@ type (
 
 func NewClient(token string, signer Signer, opts ...Opt) *Client {
 	o := options{
-		Host: Host,
-		HTTPClient: &http.Client{
-			Transport: &http.Transport{
-				Proxy: http.ProxyFromEnvironment,
-				DialContext: func() *net.Dialer {
-					return &net.Dialer{
-						Timeout:   30 * time.Second,
-						KeepAlive: 30 * time.Second,
-					}
-				}().DialContext,
-				TLSHandshakeTimeout:   10 * time.Second,
-				ExpectContinueTimeout: 1 * time.Second,
-			},
-			Timeout: 30 * time.Second,
-		},
-		NowFunc: time.Now,
+		Host:       Host,
+		HTTPClient: newDefaultHTTPClient(),
+		NowFunc:    time.Now,
 	}
 	for _, opt := range opts {
 		opt(&o)
 	}
 	return &Client{
-		host:   o.Host,
-		cli:    o.HTTPClient,
-		now:    o.NowFunc,
-		token:  token,
-		signer: signer,
+		host:    o.Host,
+		cli:     o.HTTPClient,
+		now:     o.NowFunc,
+		token:   token,
+		signer:  signer,
+		sandbox: o.Sandbox,
+		creds:   o.Credentials,
+	}
+}
+
+func newDefaultHTTPClient() *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			DialContext: func() *net.Dialer {
+				return &net.Dialer{
+					Timeout:   30 * time.Second,
+					KeepAlive: 30 * time.Second,
+				}
+			}().DialContext,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: 1 * time.Second,
+		},
+		Timeout: 30 * time.Second,
 	}
 }
 
@@ -92,6 +105,13 @@ func WithNowFunc(f NowFunc) Opt {
 	}
 }
 
+// WithSandbox marks the client as working with the sandbox, enables sandbox only methods like SimulateReview.
+func WithSandbox() Opt {
+	return func(opts *options) {
+		opts.Sandbox = true
+	}
+}
+
 type (
 	APIError struct {
 		Description   string
@@ -101,6 +121,11 @@ type (
 		ErrorName     string
 	}
 
+	// StatusError non 200 answer without SumSub error body, e.g. from a proxy or load balancer.
+	StatusError struct {
+		Code int
+	}
+
 	GenerateAccessTokenSDKRequest struct {
 		TTL       time.Duration
 		UserID    string
@@ -116,7 +141,7 @@ type (
 		LevelName string
 		UserID    string
 		TTL       time.Duration
-		Lang      string
+		Lang      string // one of Lang* constants, see NegotiateLang
 	}
 
 	GenerateExternalWebSDKLinkResponse struct {
@@ -137,16 +162,16 @@ type (
 		CreateDate          time.Time
 		ReviewDate          time.Time
 		ReviewResult        ReviewResult
-		ReviewStatus        string
+		ReviewStatus        ReviewStatus
 		Priority            int
 	}
 
 	ReviewResult struct {
 		ModerationComment string
 		ClientComment     string
-		ReviewAnswer      string
-		RejectLabels      []string
-		ReviewRejectType  string
+		ReviewAnswer      ReviewAnswer
+		RejectLabels      []RejectLabel
+		ReviewRejectType  ReviewRejectType
 	}
 
 	ApplicantDataRequest struct {
@@ -162,15 +187,24 @@ type (
 		ClientID          string
 		InspectionID      string
 		ExternalUserID    string
+		SourceKey         string
 		FixedInfo         FixedInfo
 		Info              Info
 		Email             string
+		Phone             string
 		ApplicantPlatform string
+		IPCountry         Country
+		AuthCode          string
 		Agreement         Agreement
 		Review            Review
 		RequiredIDDocs    RequiredIDDocs
 		Lang              string
 		Type              string
+		Tags              []string
+		Metadata          []Metadata
+		Questionnaires    []Questionnaire
+		// Raw complete response, including the fields not covered by the model
+		Raw json.RawMessage
 	}
 
 	CreateApplicantRequest struct {
@@ -185,65 +219,6 @@ type (
 		// only returning the ID for now, get the complete applicant's data by calling `ApplicantData`
 		ID string
 	}
-
-	FixedInfo struct {
-		FirstName string
-		LastName  string
-		DOB       time.Time
-	}
-
-	Info struct {
-		FirstName   string
-		FirstNameEn string
-		LastName    string
-		LastNameEn  string
-		DOB         time.Time
-		Country     string
-		IDDocs      []IDDoc
-	}
-
-	IDDoc struct {
-		IDDocType   string
-		Country     string
-		FirstName   string
-		FirstNameEn string
-		LastName    string
-		LastNameEn  string
-		ValidUntil  time.Time
-		Number      string
-		DOB         time.Time
-		MRZLine1    string
-		MRZLine2    string
-		MRZLine3    string
-	}
-
-	Agreement struct {
-		CreatedAt  time.Time
-		AcceptedAt time.Time
-		Source     string
-		RecordIDs  []string
-	}
-
-	Review struct {
-		ReviewID           string
-		AttemptID          string
-		AttemptCnt         int
-		LevelName          string
-		LevelAutoCheckMode string
-		CreateDate         time.Time
-		ReviewStatus       string
-		Priority           int
-	}
-
-	RequiredIDDocs struct {
-		DocSets DocSets
-	}
-
-	DocSets []DocSet
-
-	DocSet struct {
-		IDDocSetType string
-	}
 )
 
 type (
@@ -255,10 +230,6 @@ type (
 		ErrorName     string `json:"errorName"`
 	}
 
-	respTime struct {
-		time.Time
-	}
-
 	reqHealth struct {
 	}
 
@@ -287,98 +258,35 @@ type (
 	}
 
 	respApplicantReviewStatus struct {
-		ReviewID            string   `json:"reviewId"`
-		AttemptID           string   `json:"attemptId"`
-		AttemptCnt          int      `json:"attemptCnt"`
-		ElapsedSincePending int64    `json:"elapsedSincePendingMs"`
-		ElapsedSinceQueued  int64    `json:"elapsedSinceQueuedMs"`
-		Reprocessing        bool     `json:"reprocessing"`
-		CreateDate          respTime `json:"createDate"`
-		ReviewDate          respTime `json:"reviewDate"`
-		ReviewResult        struct {
-			ModerationComment string   `json:"moderationComment"`
-			ClientComment     string   `json:"clientComment"`
-			ReviewAnswer      string   `json:"reviewAnswer"`
-			RejectLabels      []string `json:"rejectLabels"`
-			ReviewRejectType  string   `json:"reviewRejectType"`
-		} `json:"reviewResult"`
-		ReviewStatus string `json:"reviewStatus"`
-		Priority     int    `json:"priority"`
+		ReviewID            string           `json:"reviewId"`
+		AttemptID           string           `json:"attemptId"`
+		AttemptCnt          int              `json:"attemptCnt"`
+		ElapsedSincePending int64            `json:"elapsedSincePendingMs"`
+		ElapsedSinceQueued  int64            `json:"elapsedSinceQueuedMs"`
+		Reprocessing        bool             `json:"reprocessing"`
+		CreateDate          Time             `json:"createDate"`
+		ReviewDate          Time             `json:"reviewDate"`
+		ReviewResult        respReviewResult `json:"reviewResult"`
+		ReviewStatus        ReviewStatus     `json:"reviewStatus"`
+		Priority            int              `json:"priority"`
+	}
+
+	respReviewResult struct {
+		ModerationComment string           `json:"moderationComment"`
+		ClientComment     string           `json:"clientComment"`
+		ReviewAnswer      ReviewAnswer     `json:"reviewAnswer"`
+		RejectLabels      []RejectLabel    `json:"rejectLabels"`
+		ReviewRejectType  ReviewRejectType `json:"reviewRejectType"`
 	}
 
 	reqApplicantData struct {
 	}
 
-	respApplicantData struct {
-		ID             string   `json:"id"`
-		CreatedAt      respTime `json:"createdAt"`
-		CreatedBy      string   `json:"createdBy"`
-		Key            string   `json:"key"`
-		ClientID       string   `json:"clientId"`
-		InspectionID   string   `json:"inspectionId"`
-		ExternalUserID string   `json:"externalUserId"`
-		FixedInfo      struct {
-			FirstName string `json:"firstName"`
-			LastName  string `json:"lastName"`
-		}
-		Info struct {
-			FirstName   string   `json:"firstName"`
-			FirstNameEn string   `json:"firstNameEn"`
-			LastName    string   `json:"lastName"`
-			LastNameEn  string   `json:"lastNameEn"`
-			DOB         respTime `json:"dob"`
-			Country     string   `json:"country"`
-			IDDocs      []struct {
-				IDDocType   string   `json:"idDocType"`
-				Country     string   `json:"country"`
-				FirstName   string   `json:"firstName"`
-				FirstNameEn string   `json:"firstNameEn"`
-				LastName    string   `json:"lastName"`
-				LastNameEn  string   `json:"lastNameEn"`
-				ValidUntil  respTime `json:"validUntil"`
-				Number      string   `json:"number"`
-				DOB         respTime `json:"dob"`
-				MRZLine1    string   `json:"mrzLine1"`
-				MRZLine2    string   `json:"mrzLine2"`
-				MRZLine3    string   `json:"mrzLine3"`
-			}
-		}
-		Email             string `json:"email"`
-		ApplicantPlatform string `json:"applicantPlatform"`
-		Agreement         struct {
-			CreatedAt  respTime `json:"createdAt"`
-			AcceptedAt respTime `json:"acceptedAt"`
-			Source     string   `json:"source"`
-			RecordIDs  []string `json:"recordIds"`
-		} `json:"agreement"`
-		RequiredIDDocs struct {
-			DocSets []struct {
-				IDDocSetType string `json:"idDocSetType"`
-			} `json:"docSets"`
-		}
-		Review struct {
-			ReviewID           string   `json:"reviewId"`
-			AttemptID          string   `json:"attemptId"`
-			AttemptCnt         int      `json:"attemptCnt"`
-			LevelName          string   `json:"levelName"`
-			LevelAutoCheckMode string   `json:"levelAutoCheckMode"`
-			CreateDate         respTime `json:"createDate"`
-			ReviewStatus       string   `json:"reviewStatus"`
-			Priority           int      `json:"priority"`
-		} `json:"review"`
-		Lang string `json:"lang"`
-		Type string `json:"type"`
-	}
-
 	reqCreateApplicant struct {
-		FixedInfo struct {
-			FirstName string `json:"firstName"`
-			LastName  string `json:"lastName"`
-			Dob       string `json:"dob,omitempty"`
-		} `json:"fixedInfo"`
-		ExternalUserID string `json:"externalUserId"`
-		Email          string `json:"email,omitempty"`
-		Phone          string `json:"phone,omitempty"`
+		FixedInfo      reqFixedInfo `json:"fixedInfo"`
+		ExternalUserID string       `json:"externalUserId"`
+		Email          string       `json:"email,omitempty"`
+		Phone          string       `json:"phone,omitempty"`
 	}
 
 	respCreateApplicant struct {
@@ -386,23 +294,14 @@ type (
 	}
 )
 
-func (t *respTime) UnmarshalJSON(b []byte) error {
-	if len(b) < 2 || b[0] != '"' || b[len(b)-1] != '"' {
-		return fmt.Errorf("invalid time format")
-	}
-	str := string(b[1 : len(b)-1])
-	var err error
-	// try to parse time with different layouts
-	for _, layout := range []string{"2006-01-02", "2006-01-02 15:04:05", "2006-01-02 15:04:05-0700"} {
-		t.Time, err = time.Parse(layout, str)
-		if err == nil {
-			break
-		}
+func (r respReviewResult) model() ReviewResult {
+	return ReviewResult{
+		ModerationComment: r.ModerationComment,
+		ClientComment:     r.ClientComment,
+		ReviewAnswer:      r.ReviewAnswer,
+		RejectLabels:      r.RejectLabels,
+		ReviewRejectType:  r.ReviewRejectType,
 	}
-	if err != nil {
-		return fmt.Errorf("parse time: undefined layout: %s", str)
-	}
-	return nil
 }
 
 // GenerateAccessTokenSDK Use this method to generate a new access token for SDK
@@ -436,6 +335,10 @@ func (c *Client) GenerateAccessTokenSDK(ctx context.Context, req GenerateAccessT
 // GenerateExternalWebSDKLink Use this method to create an external link to the WebSDK for the specified applicant
 // https://docs.sumsub.com/reference/generate-websdk-external-link
 func (c *Client) GenerateExternalWebSDKLink(ctx context.Context, req GenerateExternalWebSDKLinkRequest) (GenerateExternalWebSDKLinkResponse, error) {
+	if req.Lang != "" && !IsSupportedLang(req.Lang) {
+		return GenerateExternalWebSDKLinkResponse{}, fmt.Errorf("unsupported lang: %s", req.Lang)
+	}
+
 	resp, err := call[reqGenerateExternalWebSDKLink, respGenerateExternalWebSDKLink](ctx, c,
 		http.MethodPost,
 		(&url.URL{
@@ -484,15 +387,9 @@ func (c *Client) ApplicantReviewStatus(ctx context.Context, req ApplicantReviewS
 		Reprocessing:        resp.Reprocessing,
 		CreateDate:          resp.CreateDate.Time,
 		ReviewDate:          resp.ReviewDate.Time,
-		ReviewResult: ReviewResult{
-			ModerationComment: resp.ReviewResult.ModerationComment,
-			ClientComment:     resp.ReviewResult.ClientComment,
-			ReviewAnswer:      resp.ReviewResult.ReviewAnswer,
-			RejectLabels:      resp.ReviewResult.RejectLabels,
-			ReviewRejectType:  resp.ReviewResult.ReviewRejectType,
-		},
-		ReviewStatus: resp.ReviewStatus,
-		Priority:     resp.Priority,
+		ReviewResult:        resp.ReviewResult.model(),
+		ReviewStatus:        resp.ReviewStatus,
+		Priority:            resp.Priority,
 	}, nil
 }
 
@@ -522,101 +419,28 @@ func (c *Client) ApplicantData(ctx context.Context, req ApplicantDataRequest) (A
 		return ApplicantDataResponse{}, fmt.Errorf("call: %w", err)
 	}
 
-	return ApplicantDataResponse{
-		ID:             resp.ID,
-		CreatedAt:      resp.CreatedAt.Time,
-		CreatedBy:      resp.CreatedBy,
-		Key:            resp.Key,
-		ClientID:       resp.ClientID,
-		InspectionID:   resp.InspectionID,
-		ExternalUserID: resp.ExternalUserID,
-		FixedInfo: FixedInfo{
-			FirstName: resp.FixedInfo.FirstName,
-			LastName:  resp.FixedInfo.LastName,
-		},
-		Info: Info{
-			FirstName:   resp.Info.FirstName,
-			FirstNameEn: resp.Info.FirstNameEn,
-			LastName:    resp.Info.LastName,
-			LastNameEn:  resp.Info.LastNameEn,
-			DOB:         resp.Info.DOB.Time,
-			Country:     resp.Info.Country,
-			IDDocs: func() []IDDoc {
-				var docs []IDDoc
-				for _, d := range resp.Info.IDDocs {
-					docs = append(docs, IDDoc{
-						IDDocType:   d.IDDocType,
-						Country:     d.Country,
-						FirstName:   d.FirstName,
-						FirstNameEn: d.FirstNameEn,
-						LastName:    d.LastName,
-						LastNameEn:  d.LastNameEn,
-						ValidUntil:  d.ValidUntil.Time,
-						Number:      d.Number,
-						DOB:         d.DOB.Time,
-						MRZLine1:    d.MRZLine1,
-						MRZLine2:    d.MRZLine2,
-						MRZLine3:    d.MRZLine3,
-					})
-				}
-				return docs
-			}(),
-		},
-		Email:             resp.Email,
-		ApplicantPlatform: resp.ApplicantPlatform,
-		Agreement: struct {
-			CreatedAt  time.Time
-			AcceptedAt time.Time
-			Source     string
-			RecordIDs  []string
-		}{
-			CreatedAt:  resp.Agreement.CreatedAt.Time,
-			AcceptedAt: resp.Agreement.AcceptedAt.Time,
-			Source:     resp.Agreement.Source,
-			RecordIDs:  resp.Agreement.RecordIDs,
-		},
-		RequiredIDDocs: RequiredIDDocs{
-			DocSets: func() DocSets {
-				var sets DocSets
-				for _, s := range resp.RequiredIDDocs.DocSets {
-					sets = append(sets, DocSet{
-						IDDocSetType: s.IDDocSetType,
-					})
-				}
-				return sets
-			}(),
-		},
-		Review: Review{
-			ReviewID:           resp.Review.ReviewID,
-			AttemptID:          resp.Review.AttemptID,
-			AttemptCnt:         resp.Review.AttemptCnt,
-			LevelName:          resp.Review.LevelName,
-			LevelAutoCheckMode: resp.Review.LevelAutoCheckMode,
-			CreateDate:         resp.Review.CreateDate.Time,
-			ReviewStatus:       resp.Review.ReviewStatus,
-			Priority:           resp.Review.Priority,
-		},
-		Lang: resp.Lang,
-		Type: resp.Type,
-	}, nil
+	return resp.model(), nil
 }
 
 // CreateApplicant Use this method to create an applicant on sumsub via API.
 // https://docs.sumsub.com/reference/create-applicant
 func (c *Client) CreateApplicant(ctx context.Context, req CreateApplicantRequest) (CreateApplicantResponse, error) {
+	if req.FixedInfo.Country != "" {
+		if err := req.FixedInfo.Country.Validate(); err != nil {
+			return CreateApplicantResponse{}, fmt.Errorf("fixed info: country: %w", err)
+		}
+	}
+	if req.FixedInfo.Nationality != "" {
+		if err := req.FixedInfo.Nationality.Validate(); err != nil {
+			return CreateApplicantResponse{}, fmt.Errorf("fixed info: nationality: %w", err)
+		}
+	}
+
 	resp, err := call[reqCreateApplicant, respCreateApplicant](ctx, c,
 		http.MethodPost,
 		fmt.Sprintf("/resources/applicants?levelName=%s", url.QueryEscape(req.LevelName)),
 		reqCreateApplicant{
-			FixedInfo: struct {
-				FirstName string `json:"firstName"`
-				LastName  string `json:"lastName"`
-				Dob       string `json:"dob,omitempty"`
-			}{
-				FirstName: req.FixedInfo.FirstName,
-				LastName:  req.FixedInfo.LastName,
-				Dob:       requestTime(req.FixedInfo.DOB, "2006-01-02"),
-			},
+			FixedInfo:      newReqFixedInfo(req.FixedInfo),
 			ExternalUserID: req.ExternalUserID,
 			Email:          req.Email,
 			Phone:          req.Phone,
@@ -640,13 +464,44 @@ func (c *Client) Health(ctx context.Context) error {
 }
 
 func call[Q, A any](ctx context.Context, cli *Client, method string, uri string, query Q) (A, error) {
-	var answer A
-
 	payload, err := json.Marshal(query)
 	if err != nil {
+		var answer A
 		return answer, fmt.Errorf("marshal: %w", err)
 	}
+	return callRaw[A](ctx, cli, method, uri, "application/json", payload)
+}
+
+// callRaw sends the already encoded payload (e.g. multipart form) and decodes JSON answer.
+func callRaw[A any](ctx context.Context, cli *Client, method string, uri string, contentType string, payload []byte) (A, error) {
+	var answer A
 
+	resp, err := send(ctx, cli, method, uri, contentType, "application/json", payload)
+	if err != nil {
+		return answer, err
+	}
+	defer resp.Body.Close() //nolint: errcheck
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return answer, fmt.Errorf("read body: %w", err)
+	}
+
+	if body != nil {
+		if !json.Valid(body) {
+			return answer, fmt.Errorf("json: not valid")
+		}
+		if err = json.Unmarshal(body, &answer); err != nil {
+			return answer, fmt.Errorf("json: umarshal: %w", err)
+		}
+	}
+
+	return answer, nil
+}
+
+// send signs and sends the request. On success the caller owns the response body,
+// on non 200 status code the body is consumed and mapped to APIError when possible, to StatusError otherwise.
+func send(ctx context.Context, cli *Client, method, uri, contentType, accept string, payload []byte) (*http.Response, error) {
 	var b io.Reader
 	if len(payload) > 0 {
 		b = bytes.NewReader(payload)
@@ -656,56 +511,58 @@ func call[Q, A any](ctx context.Context, cli *Client, method string, uri string,
 
 	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("https://%s%s", cli.host, uri), b)
 	if err != nil {
-		return answer, fmt.Errorf("http: new request: %w", err)
+		return nil, fmt.Errorf("http: new request: %w", err)
+	}
+	req.Header.Set("Accept", accept)
+	req.Header.Set("Content-Type", contentType)
+	token, signer := cli.token, cli.signer
+	if cli.creds != nil {
+		creds, err := cli.creds.Credentials(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("credentials: %w", err)
+		}
+		token, signer = creds.AppToken, NewHMACSigner(creds.SecretKey)
 	}
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("X-App-Token", cli.token)
+	req.Header.Set("X-App-Token", token)
 	req.Header.Set("X-App-Access-Ts", fmt.Sprintf("%d", now.Unix()))
-	req.Header.Set("X-App-Access-Sig", cli.signer.Sign(now, method, uri, payload))
+	req.Header.Set("X-App-Access-Sig", signer.Sign(now, method, uri, payload))
 
 	resp, err := cli.cli.Do(req)
 	if err != nil {
-		return answer, fmt.Errorf("do: %w", err)
+		return nil, fmt.Errorf("do: %w", err)
+	}
+	if resp.StatusCode == http.StatusOK {
+		return resp, nil
 	}
 	defer resp.Body.Close() //nolint: errcheck
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return answer, fmt.Errorf("read body: %w", err)
-	}
-	if resp.StatusCode != http.StatusOK {
-		if body != nil && json.Valid(body) {
-			var e respError
-			if err = json.Unmarshal(body, &e); err == nil {
-				return answer, &APIError{
-					Description:   e.Description,
-					Code:          e.Code,
-					CorrelationID: e.CorrelationID,
-					ErrorCode:     e.ErrorCode,
-					ErrorName:     e.ErrorName,
-				}
+		return nil, fmt.Errorf("read body: %w", err)
+	}
+	if body != nil && json.Valid(body) {
+		var e respError
+		if err = json.Unmarshal(body, &e); err == nil {
+			return nil, &APIError{
+				Description:   e.Description,
+				Code:          e.Code,
+				CorrelationID: e.CorrelationID,
+				ErrorCode:     e.ErrorCode,
+				ErrorName:     e.ErrorName,
 			}
 		}
-		return answer, fmt.Errorf("status code: %d", resp.StatusCode)
-	}
-
-	if body != nil {
-		if !json.Valid(body) {
-			return answer, fmt.Errorf("json: not valid")
-		}
-		if err = json.Unmarshal(body, &answer); err != nil {
-			return answer, fmt.Errorf("json: umarshal: %w", err)
-		}
 	}
-
-	return answer, nil
+	return nil, &StatusError{Code: resp.StatusCode}
 }
 
 func (e *APIError) Error() string {
 	return fmt.Sprintf("%s (code: %d, errorCode: %d, correlationId: %s)", e.Description, e.Code, e.ErrorCode, e.CorrelationID)
 }
 
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("status code: %d", e.Code)
+}
+
 func AsAPIError(err error) (*APIError, bool) {
 	var e *APIError
 	if !errors.As(err, &e) {
@@ -3,6 +3,8 @@ package sumsub
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -152,3 +154,20 @@ func TestTransportModels(t *testing.T) {
 		})
 	}
 }
+
+// newTestClient returns the client calling the test server with the handler.
+func newTestClient(t *testing.T, h http.HandlerFunc, opts ...Opt) *Client {
+	t.Helper()
+	srv := httptest.NewTLSServer(h)
+	t.Cleanup(srv.Close)
+	return NewClient("token", NewHMACSigner("secret"), append([]Opt{
+		WithHost(srv.Listener.Addr().String()),
+		WithHTTPClient(srv.Client()),
+	}, opts...)...)
+}
+
+// writeAPIError writes SumSub error response.
+func writeAPIError(w http.ResponseWriter, code, errorCode int) {
+	w.WriteHeader(code)
+	_, _ = fmt.Fprintf(w, `{"description":"error","code":%d,"correlationId":"req-1","errorCode":%d}`, code, errorCode)
+}
@@ -0,0 +1,68 @@
+// Command sumsub is a helper tool for SumSub integrations.
+//
+// Usage:
+//
+//	sumsub webhook verify -secret <key> -alg <algo> -digest <hex> [-file <path>]
+//	sumsub webhook sign -secret <key> [-alg <algo>] [-file <path>]
+//	sumsub webhook listen -secret <key> [-addr :8080] [-path /]
+//
+// The webhook secret key could be passed via SUMSUB_WEBHOOK_SECRET environment variable.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+)
+
+const usage = `usage: sumsub <command> [arguments]
+
+commands:
+  webhook verify   verify a captured webhook payload against the secret
+  webhook sign     sign a payload and print X-Payload-Digest headers
+  webhook listen   run a local listener printing decoded and verified webhooks
+`
+
+var errUsage = errors.New("invalid usage")
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := run(ctx, os.Args[1:], os.Stdin, os.Stdout, os.Stderr); err != nil {
+		if errors.Is(err, errUsage) {
+			_, _ = fmt.Fprint(os.Stderr, usage)
+			os.Exit(2)
+		}
+		_, _ = fmt.Fprintf(os.Stderr, "sumsub: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	if len(args) < 2 {
+		return errUsage
+	}
+	switch args[0] {
+	case "webhook":
+		switch args[1] {
+		case "verify":
+			return webhookVerify(args[2:], stdin, stdout)
+		case "sign":
+			return webhookSign(args[2:], stdin, stdout)
+		case "listen":
+			return webhookListen(ctx, args[2:], stdout, stderr)
+		}
+	}
+	return errUsage
+}
+
+func readPayload(path string, stdin io.Reader) ([]byte, error) {
+	if path == "" || path == "-" {
+		return io.ReadAll(stdin)
+	}
+	return os.ReadFile(path)
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	sumsub "github.com/thrownew/go-sumsub"
+)
+
+const envWebhookSecret = "SUMSUB_WEBHOOK_SECRET"
+
+func webhookVerify(args []string, stdin io.Reader, stdout io.Writer) error {
+	fs := flag.NewFlagSet("webhook verify", flag.ContinueOnError)
+	secret := fs.String("secret", os.Getenv(envWebhookSecret), "webhook secret key")
+	alg := fs.String("alg", sumsub.WebhookDigestAlgSHA256, "value of X-Payload-Digest-Alg header")
+	digest := fs.String("digest", "", "value of X-Payload-Digest header")
+	file := fs.String("file", "-", "payload file, - for stdin")
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+
+	payload, err := readPayload(*file, stdin)
+	if err != nil {
+		return fmt.Errorf("read payload: %w", err)
+	}
+	if err = sumsub.VerifyWebhookDigest(payload, *secret, *alg, *digest); err != nil {
+		// print the expected digest to help with debugging of signature mismatches
+		if expected, sErr := sumsub.SignWebhookPayload(payload, *secret, *alg); sErr == nil {
+			_, _ = fmt.Fprintf(stdout, "expected digest: %s\n", expected)
+		}
+		return fmt.Errorf("verify: %w", err)
+	}
+	_, _ = fmt.Fprintln(stdout, "OK")
+	return nil
+}
+
+func webhookSign(args []string, stdin io.Reader, stdout io.Writer) error {
+	fs := flag.NewFlagSet("webhook sign", flag.ContinueOnError)
+	secret := fs.String("secret", os.Getenv(envWebhookSecret), "webhook secret key")
+	alg := fs.String("alg", sumsub.WebhookDigestAlgSHA256, "digest algorithm")
+	file := fs.String("file", "-", "payload file, - for stdin")
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+
+	payload, err := readPayload(*file, stdin)
+	if err != nil {
+		return fmt.Errorf("read payload: %w", err)
+	}
+	digest, err := sumsub.SignWebhookPayload(payload, *secret, *alg)
+	if err != nil {
+		return fmt.Errorf("sign: %w", err)
+	}
+	_, _ = fmt.Fprintf(stdout, "X-Payload-Digest: %s\nX-Payload-Digest-Alg: %s\n", digest, *alg)
+	return nil
+}
+
+func webhookListen(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("webhook listen", flag.ContinueOnError)
+	secret := fs.String("secret", os.Getenv(envWebhookSecret), "webhook secret key")
+	addr := fs.String("addr", ":8080", "listen address")
+	path := fs.String("path", "/", "webhook path")
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+	if *secret == "" {
+		return errors.New("empty secret key")
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(*path, webhookHandler(*secret, stdout, stderr))
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		sCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = srv.Shutdown(sCtx)
+	}()
+
+	_, _ = fmt.Fprintf(stderr, "listening on %s%s\n", *addr, *path)
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("listen: %w", err)
+	}
+	return nil
+}
+
+func webhookHandler(secret string, stdout, stderr io.Writer) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "read body", http.StatusBadRequest)
+			return
+		}
+
+		alg := r.Header.Get("X-Payload-Digest-Alg")
+		if err = sumsub.VerifyWebhookDigest(payload, secret, alg, r.Header.Get("X-Payload-Digest")); err != nil {
+			_, _ = fmt.Fprintf(stderr, "%s %s: verify: %v\n", r.Method, r.URL.Path, err)
+			if expected, sErr := sumsub.SignWebhookPayload(payload, secret, alg); sErr == nil {
+				_, _ = fmt.Fprintf(stderr, "expected digest: %s\n", expected)
+			}
+			http.Error(w, "invalid digest", http.StatusUnauthorized)
+			return
+		}
+
+		wh, err := sumsub.ParseWebhook(payload)
+		if err != nil {
+			_, _ = fmt.Fprintf(stderr, "%s %s: parse: %v\n", r.Method, r.URL.Path, err)
+			http.Error(w, "invalid payload", http.StatusBadRequest)
+			return
+		}
+
+		var pretty bytes.Buffer
+		if err = json.Indent(&pretty, payload, "", "  "); err != nil {
+			pretty.Reset()
+			pretty.Write(payload)
+		}
+		_, _ = fmt.Fprintf(stdout, "%s applicant=%s externalUser=%s status=%s answer=%s\n%s\n",
+			wh.Type, wh.ApplicantID, wh.ExternalUserID, wh.ReviewStatus, wh.ReviewResult.ReviewAnswer, pretty.String())
+		w.WriteHeader(http.StatusOK)
+	})
+}
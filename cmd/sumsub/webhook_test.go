@@ -0,0 +1,71 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPayload = `{"applicantId":"5cb56e8e0a975a35f333cb83","type":"applicantReviewed","reviewStatus":"completed","reviewResult":{"reviewAnswer":"GREEN"}}`
+
+func TestWebhookSignVerify(t *testing.T) {
+	var out bytes.Buffer
+	err := run(context.Background(), []string{"webhook", "sign", "-secret", "secret"}, strings.NewReader(testPayload), &out, &out)
+	require.NoError(t, err)
+
+	var digest string
+	for _, line := range strings.Split(out.String(), "\n") {
+		if strings.HasPrefix(line, "X-Payload-Digest: ") {
+			digest = strings.TrimPrefix(line, "X-Payload-Digest: ")
+		}
+	}
+	require.NotEmpty(t, digest)
+
+	out.Reset()
+	err = run(context.Background(), []string{"webhook", "verify", "-secret", "secret", "-digest", digest}, strings.NewReader(testPayload), &out, &out)
+	require.NoError(t, err)
+	assert.Equal(t, "OK\n", out.String())
+
+	out.Reset()
+	err = run(context.Background(), []string{"webhook", "verify", "-secret", "other", "-digest", digest}, strings.NewReader(testPayload), &out, &out)
+	require.EqualError(t, err, "verify: digest mismatch")
+	assert.Contains(t, out.String(), "expected digest: ")
+}
+
+func TestWebhookHandler(t *testing.T) {
+	var stdout, stderr, sign bytes.Buffer
+	err := run(context.Background(), []string{"webhook", "sign", "-secret", "secret"}, strings.NewReader(testPayload), &sign, &sign)
+	require.NoError(t, err)
+
+	h := webhookHandler("secret", &stdout, &stderr)
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(testPayload))
+	for _, line := range strings.Split(strings.TrimSpace(sign.String()), "\n") {
+		k, v, _ := strings.Cut(line, ": ")
+		req.Header.Set(k, v)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, stdout.String(), "applicantReviewed applicant=5cb56e8e0a975a35f333cb83")
+
+	req = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(testPayload))
+	req.Header.Set("X-Payload-Digest-Alg", "HMAC_SHA256_HEX")
+	req.Header.Set("X-Payload-Digest", "00")
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Contains(t, stderr.String(), "digest mismatch")
+}
+
+func TestRunUsage(t *testing.T) {
+	var out bytes.Buffer
+	assert.ErrorIs(t, run(context.Background(), nil, nil, &out, &out), errUsage)
+	assert.ErrorIs(t, run(context.Background(), []string{"webhook", "unknown"}, nil, &out, &out), errUsage)
+}
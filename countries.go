@@ -0,0 +1,119 @@
+package sumsub
+
+import (
+	_ "embed" // countries table
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Country ISO 3166-1 alpha-3 country code as SumSub uses it, e.g. "DEU".
+// Kosovo is represented by the user-assigned "XKX" code.
+type Country string
+
+type (
+	// CountryInfo entry of the ISO 3166-1 table.
+	CountryInfo struct {
+		Alpha2  string
+		Alpha3  Country
+		Numeric string
+		Name    string
+	}
+)
+
+//go:embed countries.csv
+var countriesCSV string
+
+var countries struct {
+	once   sync.Once
+	list   []CountryInfo
+	byCode map[string]CountryInfo // alpha-2, alpha-3 and numeric codes
+}
+
+func loadCountries() {
+	records, err := csv.NewReader(strings.NewReader(countriesCSV)).ReadAll()
+	if err != nil {
+		panic(fmt.Errorf("countries: csv: %w", err)) // embedded table is broken
+	}
+	countries.byCode = make(map[string]CountryInfo, len(records)*3)
+	for _, r := range records[1:] { // skip header
+		info := CountryInfo{
+			Alpha2:  r[0],
+			Alpha3:  Country(r[1]),
+			Numeric: r[2],
+			Name:    r[3],
+		}
+		countries.list = append(countries.list, info)
+		countries.byCode[info.Alpha2] = info
+		countries.byCode[string(info.Alpha3)] = info
+		if info.Numeric != "" {
+			countries.byCode[info.Numeric] = info
+		}
+	}
+	sort.Slice(countries.list, func(i, j int) bool {
+		return countries.list[i].Alpha3 < countries.list[j].Alpha3
+	})
+}
+
+// Countries returns the ISO 3166-1 table sorted by alpha-3 code.
+func Countries() []CountryInfo {
+	countries.once.Do(loadCountries)
+	return append([]CountryInfo(nil), countries.list...)
+}
+
+// LookupCountry finds the country by alpha-2, alpha-3 or numeric code, case-insensitive.
+func LookupCountry(code string) (CountryInfo, bool) {
+	countries.once.Do(loadCountries)
+	info, ok := countries.byCode[strings.ToUpper(strings.TrimSpace(code))]
+	return info, ok
+}
+
+// ParseCountry converts alpha-2, alpha-3 or numeric code to the Country.
+func ParseCountry(code string) (Country, error) {
+	info, ok := LookupCountry(code)
+	if !ok {
+		return "", fmt.Errorf("unknown country: %s", code)
+	}
+	return info.Alpha3, nil
+}
+
+// Validate returns an error if the country is not a known ISO 3166-1 alpha-3 code.
+func (c Country) Validate() error {
+	if _, ok := c.info(); !ok {
+		return fmt.Errorf("invalid country: %s", c)
+	}
+	return nil
+}
+
+// Alpha2 returns ISO 3166-1 alpha-2 code or empty string for unknown countries.
+func (c Country) Alpha2() string {
+	info, _ := c.info()
+	return info.Alpha2
+}
+
+// Numeric returns ISO 3166-1 numeric code or empty string for unknown countries.
+func (c Country) Numeric() string {
+	info, _ := c.info()
+	return info.Numeric
+}
+
+// Name returns the country short name or empty string for unknown countries.
+func (c Country) Name() string {
+	info, _ := c.info()
+	return info.Name
+}
+
+func (c Country) String() string {
+	return string(c)
+}
+
+func (c Country) info() (CountryInfo, bool) {
+	countries.once.Do(loadCountries)
+	info, ok := countries.byCode[string(c)]
+	if !ok || info.Alpha3 != c {
+		return CountryInfo{}, false
+	}
+	return info, true
+}
@@ -0,0 +1,45 @@
+package sumsub
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountries(t *testing.T) {
+	all := Countries()
+	assert.Len(t, all, 250)
+	for _, c := range all {
+		assert.NoError(t, c.Alpha3.Validate())
+		assert.Len(t, c.Alpha2, 2)
+	}
+
+	for _, code := range []string{"DE", "de", "DEU", "deu", "276"} {
+		c, err := ParseCountry(code)
+		require.NoError(t, err, code)
+		assert.Equal(t, Country("DEU"), c, code)
+	}
+	_, err := ParseCountry("ZZZ")
+	assert.EqualError(t, err, "unknown country: ZZZ")
+
+	c := Country("USA")
+	assert.Equal(t, "US", c.Alpha2())
+	assert.Equal(t, "840", c.Numeric())
+	assert.Equal(t, "United States", c.Name())
+	assert.Equal(t, "XK", Country("XKX").Alpha2())
+
+	assert.EqualError(t, Country("deu").Validate(), "invalid country: deu")
+	assert.EqualError(t, Country("DE").Validate(), "invalid country: DE")
+	assert.EqualError(t, Country("276").Validate(), "invalid country: 276")
+	assert.Equal(t, "", Country("276").Alpha2())
+}
+
+func TestCreateApplicantValidation(t *testing.T) {
+	cli := NewClient("token", NewHMACSigner("secret"))
+	_, err := cli.CreateApplicant(context.Background(), CreateApplicantRequest{FixedInfo: FixedInfo{Country: "DE"}})
+	assert.EqualError(t, err, "fixed info: country: invalid country: DE")
+	_, err = cli.CreateApplicant(context.Background(), CreateApplicantRequest{FixedInfo: FixedInfo{Nationality: "XXX"}})
+	assert.EqualError(t, err, "fixed info: nationality: invalid country: XXX")
+}
@@ -0,0 +1,334 @@
+package sumsub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultCredentialsRetryInterval = 5 * time.Second
+	// credentialsReadAttempts max reads of the credential files changed while being read
+	credentialsReadAttempts = 3
+)
+
+type (
+	Credentials struct {
+		AppToken  string
+		SecretKey string
+	}
+
+	// CredentialsProvider is consulted by the client on every request, so the credentials can rotate
+	// without the client restart. Implementations must be safe for concurrent use.
+	CredentialsProvider interface {
+		Credentials(ctx context.Context) (Credentials, error)
+	}
+
+	CredentialsProviderFunc func(ctx context.Context) (Credentials, error)
+
+	// EnvCredentialsProvider reads credentials from environment variables on every call.
+	EnvCredentialsProvider struct {
+		tokenVar  string
+		secretVar string
+	}
+
+	// FileCredentialsProvider reads the app token and the secret key from files, e.g. rendered by the secret manager agent.
+	// Files are checked for changes (modification time and size) at most once per check interval and re-read if changed.
+	// If re-reading fails the previous credentials are kept until the next check and the error is reported
+	// to the WithCredentialsErrorHandler handler. Files changed while being read are read again.
+	FileCredentialsProvider struct {
+		tokenPath     string
+		secretPath    string
+		checkInterval time.Duration
+		onError       func(error)
+		readFile      func(name string) ([]byte, error)
+
+		mu      sync.Mutex
+		creds   Credentials
+		loaded  bool
+		checked time.Time
+		stats   [2]fileStat
+	}
+
+	// CachedCredentialsProvider caches credentials of the underlying provider for TTL.
+	// Only one caller refreshes expired credentials at a time, others get the cached credentials meanwhile
+	// or wait for the refresh if nothing was loaded yet.
+	// If the refresh fails the cached credentials (or the error if nothing was loaded yet) are returned
+	// without calling the underlying provider until the retry interval passes.
+	CachedCredentialsProvider struct {
+		provider      CredentialsProvider
+		ttl           time.Duration
+		retryInterval time.Duration
+		onError       func(error)
+
+		mu         sync.Mutex
+		creds      Credentials
+		loaded     bool
+		fetched    time.Time
+		err        error         // last refresh error
+		retryAt    time.Time     // no refresh attempts before
+		refreshing chan struct{} // closed when the refresh in progress is over, nil if none
+	}
+
+	credentialsProviderOptions struct {
+		ErrorHandler  func(error)
+		RetryInterval time.Duration
+	}
+
+	CredentialsProviderOpt func(*credentialsProviderOptions)
+
+	fileStat struct {
+		modTime time.Time
+		size    int64
+	}
+)
+
+// WithCredentialsProvider makes the client take the app token and the secret key from the provider
+// on every request instead of the NewClient token and signer.
+func WithCredentialsProvider(p CredentialsProvider) Opt {
+	return func(opts *options) {
+		opts.Credentials = p
+	}
+}
+
+// NewClientWithCredentials creates the client using the credentials provider, see WithCredentialsProvider.
+func NewClientWithCredentials(p CredentialsProvider, opts ...Opt) *Client {
+	return NewClient("", nil, append(opts, WithCredentialsProvider(p))...)
+}
+
+// WithCredentialsErrorHandler h is called on every failed refresh of the file or cached provider,
+// including the ones hidden by returning the previous credentials.
+func WithCredentialsErrorHandler(h func(error)) CredentialsProviderOpt {
+	return func(opts *credentialsProviderOptions) {
+		opts.ErrorHandler = h
+	}
+}
+
+// WithCredentialsRetryInterval min interval between the refresh attempts of the cached provider after a failure,
+// 5s by default.
+func WithCredentialsRetryInterval(d time.Duration) CredentialsProviderOpt {
+	return func(opts *credentialsProviderOptions) {
+		opts.RetryInterval = d
+	}
+}
+
+func newCredentialsProviderOptions(opts []CredentialsProviderOpt) credentialsProviderOptions {
+	o := credentialsProviderOptions{
+		RetryInterval: defaultCredentialsRetryInterval,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+func (f CredentialsProviderFunc) Credentials(ctx context.Context) (Credentials, error) {
+	return f(ctx)
+}
+
+// Validate checks that the credentials are not empty.
+func (c Credentials) Validate() error {
+	if c.AppToken == "" {
+		return errors.New("empty app token")
+	}
+	if c.SecretKey == "" {
+		return errors.New("empty secret key")
+	}
+	return nil
+}
+
+// NewEnvCredentialsProvider reads <prefix>_APP_TOKEN and <prefix>_SECRET_KEY, DefaultEnvPrefix is used if prefix is empty.
+func NewEnvCredentialsProvider(prefix string) *EnvCredentialsProvider {
+	if prefix == "" {
+		prefix = DefaultEnvPrefix
+	}
+	return &EnvCredentialsProvider{
+		tokenVar:  prefix + EnvSuffixAppToken,
+		secretVar: prefix + EnvSuffixSecretKey,
+	}
+}
+
+func (p *EnvCredentialsProvider) Credentials(_ context.Context) (Credentials, error) {
+	c := Credentials{
+		AppToken:  os.Getenv(p.tokenVar),
+		SecretKey: os.Getenv(p.secretVar),
+	}
+	if err := c.Validate(); err != nil {
+		return Credentials{}, fmt.Errorf("env %s, %s: %w", p.tokenVar, p.secretVar, err)
+	}
+	return c, nil
+}
+
+// NewFileCredentialsProvider reads credentials from the files, surrounding whitespace is trimmed.
+// Files are checked for changes every call if checkInterval is zero.
+func NewFileCredentialsProvider(tokenPath, secretPath string, checkInterval time.Duration, opts ...CredentialsProviderOpt) *FileCredentialsProvider {
+	o := newCredentialsProviderOptions(opts)
+	return &FileCredentialsProvider{
+		tokenPath:     tokenPath,
+		secretPath:    secretPath,
+		checkInterval: checkInterval,
+		onError:       o.ErrorHandler,
+		readFile:      os.ReadFile,
+	}
+}
+
+func (p *FileCredentialsProvider) Credentials(_ context.Context) (Credentials, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	if p.loaded && now.Sub(p.checked) < p.checkInterval {
+		return p.creds, nil
+	}
+	p.checked = now
+
+	stats, err := p.stat()
+	if err == nil && p.loaded && stats == p.stats {
+		return p.creds, nil
+	}
+	var creds Credentials
+	if err == nil {
+		creds, stats, err = p.load(stats)
+	}
+	if err != nil {
+		if p.onError != nil {
+			p.onError(err)
+		}
+		if p.loaded {
+			return p.creds, nil
+		}
+		return Credentials{}, err
+	}
+	p.creds, p.stats, p.loaded = creds, stats, true
+	return creds, nil
+}
+
+func (p *FileCredentialsProvider) stat() ([2]fileStat, error) {
+	var res [2]fileStat
+	for i, path := range []string{p.tokenPath, p.secretPath} {
+		fi, err := os.Stat(path)
+		if err != nil {
+			return res, fmt.Errorf("stat: %w", err)
+		}
+		res[i] = fileStat{modTime: fi.ModTime(), size: fi.Size()}
+	}
+	return res, nil
+}
+
+// load reads the files until they are not changed while being read, stats are taken before the read.
+func (p *FileCredentialsProvider) load(stats [2]fileStat) (Credentials, [2]fileStat, error) {
+	for attempt := 1; ; attempt++ {
+		creds, err := p.read()
+		if err != nil {
+			return Credentials{}, stats, err
+		}
+		after, err := p.stat()
+		if err != nil {
+			return Credentials{}, stats, err
+		}
+		if after == stats {
+			return creds, stats, nil
+		}
+		if attempt == credentialsReadAttempts {
+			return Credentials{}, stats, errors.New("files changed while being read")
+		}
+		stats = after
+	}
+}
+
+func (p *FileCredentialsProvider) read() (Credentials, error) {
+	token, err := p.readFile(p.tokenPath)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("read app token: %w", err)
+	}
+	secret, err := p.readFile(p.secretPath)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("read secret key: %w", err)
+	}
+	c := Credentials{
+		AppToken:  strings.TrimSpace(string(token)),
+		SecretKey: strings.TrimSpace(string(secret)),
+	}
+	if err = c.Validate(); err != nil {
+		return Credentials{}, err
+	}
+	return c, nil
+}
+
+func NewCachedCredentialsProvider(p CredentialsProvider, ttl time.Duration, opts ...CredentialsProviderOpt) *CachedCredentialsProvider {
+	o := newCredentialsProviderOptions(opts)
+	return &CachedCredentialsProvider{
+		provider:      p,
+		ttl:           ttl,
+		retryInterval: o.RetryInterval,
+		onError:       o.ErrorHandler,
+	}
+}
+
+func (p *CachedCredentialsProvider) Credentials(ctx context.Context) (Credentials, error) {
+	for {
+		p.mu.Lock()
+		now := time.Now()
+		if p.loaded && now.Sub(p.fetched) < p.ttl {
+			creds := p.creds
+			p.mu.Unlock()
+			return creds, nil
+		}
+		if p.refreshing != nil || (p.err != nil && now.Before(p.retryAt)) {
+			// refresh is in progress or backed off
+			creds, loaded, err, refreshing := p.creds, p.loaded, p.err, p.refreshing
+			p.mu.Unlock()
+			switch {
+			case loaded:
+				return creds, nil
+			case refreshing == nil:
+				return Credentials{}, err
+			}
+			select {
+			case <-refreshing:
+				continue
+			case <-ctx.Done():
+				return Credentials{}, ctx.Err()
+			}
+		}
+		refreshing := make(chan struct{})
+		p.refreshing = refreshing
+		p.mu.Unlock()
+
+		return p.refresh(ctx, now, refreshing)
+	}
+}
+
+// refresh calls the underlying provider outside the lock, the callers waiting for it are woken up when it is over.
+func (p *CachedCredentialsProvider) refresh(ctx context.Context, now time.Time, refreshing chan struct{}) (Credentials, error) {
+	creds, err := p.provider.Credentials(ctx)
+
+	p.mu.Lock()
+	p.refreshing = nil
+	close(refreshing)
+	switch {
+	case err == nil:
+		p.creds, p.loaded, p.fetched, p.err = creds, true, now, nil
+		p.mu.Unlock()
+		return creds, nil
+	case ctx.Err() != nil:
+		// the caller gave up, not the provider failure, a waiter takes over the refresh
+		p.mu.Unlock()
+		return Credentials{}, err
+	}
+	p.err, p.retryAt = err, now.Add(p.retryInterval)
+	cached, loaded := p.creds, p.loaded
+	p.mu.Unlock()
+
+	if p.onError != nil {
+		p.onError(err)
+	}
+	if loaded {
+		return cached, nil
+	}
+	return Credentials{}, err
+}
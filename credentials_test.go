@@ -0,0 +1,268 @@
+package sumsub
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CredentialsProvider(t *testing.T) {
+	var n int32
+	provider := CredentialsProviderFunc(func(ctx context.Context) (Credentials, error) {
+		i := atomic.AddInt32(&n, 1)
+		if i == 3 {
+			return Credentials{}, errors.New("agent unavailable")
+		}
+		return Credentials{AppToken: "prd:token-" + strconv.Itoa(int(i)), SecretKey: "secret-" + strconv.Itoa(int(i))}, nil
+	})
+
+	var tokens []string
+	cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		token := r.Header.Get("X-App-Token")
+		tokens = append(tokens, token)
+		ts, err := strconv.ParseInt(r.Header.Get("X-App-Access-Ts"), 10, 64)
+		if !assert.NoError(t, err) {
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		secret := "secret-" + token[len("prd:token-"):]
+		assert.Equal(t, NewHMACSigner(secret).Sign(time.Unix(ts, 0), r.Method, r.URL.RequestURI(), body), r.Header.Get("X-App-Access-Sig"))
+		_, _ = w.Write([]byte(`{"reviewStatus":"pending"}`))
+	}, WithCredentialsProvider(provider))
+
+	for i := 0; i < 2; i++ {
+		_, err := cli.ApplicantReviewStatus(context.Background(), ApplicantReviewStatusRequest{ApplicantID: "app-1"})
+		require.NoError(t, err)
+	}
+	_, err := cli.ApplicantReviewStatus(context.Background(), ApplicantReviewStatusRequest{ApplicantID: "app-1"})
+	assert.EqualError(t, err, "call: credentials: agent unavailable")
+	assert.Equal(t, []string{"prd:token-1", "prd:token-2"}, tokens)
+}
+
+func TestEnvCredentialsProvider(t *testing.T) {
+	p := NewEnvCredentialsProvider("")
+	t.Setenv("SUMSUB_APP_TOKEN", "")
+	_, err := p.Credentials(context.Background())
+	assert.EqualError(t, err, "env SUMSUB_APP_TOKEN, SUMSUB_SECRET_KEY: empty app token")
+
+	t.Setenv("SUMSUB_APP_TOKEN", "prd:token")
+	t.Setenv("SUMSUB_SECRET_KEY", "secret")
+	creds, err := p.Credentials(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Credentials{AppToken: "prd:token", SecretKey: "secret"}, creds)
+}
+
+func TestFileCredentialsProvider(t *testing.T) {
+	dir := t.TempDir()
+	tokenPath, secretPath := filepath.Join(dir, "token"), filepath.Join(dir, "secret")
+
+	var errs []error
+	p := NewFileCredentialsProvider(tokenPath, secretPath, 0, WithCredentialsErrorHandler(func(err error) {
+		errs = append(errs, err)
+	}))
+	_, err := p.Credentials(context.Background())
+	assert.Error(t, err)
+
+	require.NoError(t, os.WriteFile(tokenPath, []byte("prd:token\n"), 0o600))
+	require.NoError(t, os.WriteFile(secretPath, []byte("secret\n"), 0o600))
+	creds, err := p.Credentials(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Credentials{AppToken: "prd:token", SecretKey: "secret"}, creds)
+
+	// rotation, the size differs so the change is detected regardless of mtime granularity
+	require.NoError(t, os.WriteFile(tokenPath, []byte("prd:rotated-token\n"), 0o600))
+	creds, err = p.Credentials(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "prd:rotated-token", creds.AppToken)
+
+	// broken file keeps the previous credentials
+	require.NoError(t, os.Remove(secretPath))
+	creds, err = p.Credentials(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Credentials{AppToken: "prd:rotated-token", SecretKey: "secret"}, creds)
+	require.Len(t, errs, 2)
+	assert.ErrorIs(t, errs[1], os.ErrNotExist)
+
+	// changes are not checked within the interval
+	require.NoError(t, os.WriteFile(secretPath, []byte("secret\n"), 0o600))
+	p = NewFileCredentialsProvider(tokenPath, secretPath, time.Hour)
+	_, err = p.Credentials(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(tokenPath, []byte("prd:token\n"), 0o600))
+	creds, err = p.Credentials(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "prd:rotated-token", creds.AppToken)
+}
+
+func TestFileCredentialsProvider_ChangedWhileReading(t *testing.T) {
+	dir := t.TempDir()
+	tokenPath, secretPath := filepath.Join(dir, "token"), filepath.Join(dir, "secret")
+	require.NoError(t, os.WriteFile(tokenPath, []byte("prd:token-1\n"), 0o600))
+	require.NoError(t, os.WriteFile(secretPath, []byte("secret-1\n"), 0o600))
+
+	p := NewFileCredentialsProvider(tokenPath, secretPath, 0)
+	var reads int
+	p.readFile = func(name string) ([]byte, error) {
+		reads++
+		if reads == 1 {
+			// the agent rotates both files after the token is read
+			require.NoError(t, os.WriteFile(tokenPath, []byte("prd:token-22\n"), 0o600))
+			require.NoError(t, os.WriteFile(secretPath, []byte("secret-22\n"), 0o600))
+		}
+		return os.ReadFile(name)
+	}
+	creds, err := p.Credentials(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Credentials{AppToken: "prd:token-22", SecretKey: "secret-22"}, creds)
+	assert.Equal(t, 4, reads)
+
+	// files keep changing
+	size := 0
+	p = NewFileCredentialsProvider(tokenPath, secretPath, 0)
+	p.readFile = func(name string) ([]byte, error) {
+		size++
+		require.NoError(t, os.WriteFile(tokenPath, []byte("prd:token-"+strings.Repeat("x", size)), 0o600))
+		return os.ReadFile(name)
+	}
+	_, err = p.Credentials(context.Background())
+	assert.EqualError(t, err, "files changed while being read")
+}
+
+func TestCachedCredentialsProvider_Refresh(t *testing.T) {
+	var calls int32
+	release := make(chan struct{})
+	p := NewCachedCredentialsProvider(CredentialsProviderFunc(func(ctx context.Context) (Credentials, error) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			return Credentials{AppToken: "prd:token-1", SecretKey: "secret"}, nil
+		}
+		select {
+		case <-release:
+		case <-ctx.Done():
+			return Credentials{}, ctx.Err()
+		}
+		return Credentials{AppToken: "prd:token-2", SecretKey: "secret"}, nil
+	}), time.Hour)
+
+	_, err := p.Credentials(context.Background())
+	require.NoError(t, err)
+	p.mu.Lock()
+	p.fetched = time.Time{}
+	p.mu.Unlock()
+
+	// the refresh is blocked, other callers get the cached credentials
+	refreshed := make(chan Credentials)
+	go func() {
+		creds, err := p.Credentials(context.Background())
+		assert.NoError(t, err)
+		refreshed <- creds
+	}()
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&calls) == 2 }, 5*time.Second, time.Millisecond)
+	for i := 0; i < 3; i++ {
+		creds, err := p.Credentials(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "prd:token-1", creds.AppToken)
+	}
+	close(release)
+	assert.Equal(t, "prd:token-2", (<-refreshed).AppToken)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+	// nothing loaded yet, waiters respect their context and take over the refresh if the caller gives up
+	started := make(chan struct{})
+	var n int32
+	empty := NewCachedCredentialsProvider(CredentialsProviderFunc(func(ctx context.Context) (Credentials, error) {
+		if atomic.AddInt32(&n, 1) == 1 {
+			close(started)
+			<-ctx.Done()
+			return Credentials{}, ctx.Err()
+		}
+		return Credentials{AppToken: "prd:token", SecretKey: "secret"}, nil
+	}), time.Hour)
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	leaderErr := make(chan error)
+	go func() {
+		_, err := empty.Credentials(leaderCtx)
+		leaderErr <- err
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = empty.Credentials(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	waiter := make(chan Credentials)
+	go func() {
+		creds, err := empty.Credentials(context.Background())
+		assert.NoError(t, err)
+		waiter <- creds
+	}()
+	cancelLeader()
+	assert.ErrorIs(t, <-leaderErr, context.Canceled)
+	assert.Equal(t, "prd:token", (<-waiter).AppToken)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&n))
+}
+
+func TestCachedCredentialsProvider(t *testing.T) {
+	var (
+		calls int32
+		fail  atomic.Bool
+		errs  []error
+	)
+	p := NewCachedCredentialsProvider(CredentialsProviderFunc(func(ctx context.Context) (Credentials, error) {
+		atomic.AddInt32(&calls, 1)
+		if fail.Load() {
+			return Credentials{}, errors.New("unavailable")
+		}
+		return Credentials{AppToken: "prd:token", SecretKey: "secret"}, nil
+	}), time.Hour, WithCredentialsRetryInterval(time.Hour), WithCredentialsErrorHandler(func(err error) {
+		errs = append(errs, err)
+	}))
+
+	for i := 0; i < 3; i++ {
+		_, err := p.Credentials(context.Background())
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	p.ttl = 0
+	fail.Store(true)
+	for i := 0; i < 3; i++ {
+		creds, err := p.Credentials(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "prd:token", creds.AppToken)
+	}
+	// failed refresh is not retried within the retry interval
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	require.Len(t, errs, 1)
+	assert.EqualError(t, errs[0], "unavailable")
+
+	p.retryAt = time.Time{}
+	fail.Store(false)
+	_, err := p.Credentials(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	assert.NoError(t, p.err)
+
+	// nothing loaded, the error is cached for the retry interval
+	calls = 0
+	empty := NewCachedCredentialsProvider(CredentialsProviderFunc(func(ctx context.Context) (Credentials, error) {
+		atomic.AddInt32(&calls, 1)
+		return Credentials{}, errors.New("unavailable")
+	}), time.Hour)
+	for i := 0; i < 3; i++ {
+		_, err = empty.Credentials(context.Background())
+		assert.EqualError(t, err, "unavailable")
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
@@ -0,0 +1,86 @@
+package sumsub
+
+import (
+	"fmt"
+)
+
+// IDDocType type of the applicant document.
+// https://docs.sumsub.com/reference/add-id-documents
+type IDDocType string
+
+const (
+	IDDocTypePassport                       IDDocType = "PASSPORT"
+	IDDocTypeIDCard                         IDDocType = "ID_CARD"
+	IDDocTypeDrivers                        IDDocType = "DRIVERS"
+	IDDocTypeDriversTranslation             IDDocType = "DRIVERS_TRANSLATION"
+	IDDocTypeResidencePermit                IDDocType = "RESIDENCE_PERMIT"
+	IDDocTypeSelfie                         IDDocType = "SELFIE"
+	IDDocTypeVideoSelfie                    IDDocType = "VIDEO_SELFIE"
+	IDDocTypeProfileImage                   IDDocType = "PROFILE_IMAGE"
+	IDDocTypeIDDocPhoto                     IDDocType = "ID_DOC_PHOTO"
+	IDDocTypeUtilityBill                    IDDocType = "UTILITY_BILL"
+	IDDocTypeUtilityBill2                   IDDocType = "UTILITY_BILL2"
+	IDDocTypeBankStatement                  IDDocType = "BANK_STATEMENT"
+	IDDocTypeBankCard                       IDDocType = "BANK_CARD"
+	IDDocTypePaymentMethod                  IDDocType = "PAYMENT_METHOD"
+	IDDocTypeIncomeSource                   IDDocType = "INCOME_SOURCE"
+	IDDocTypeEmploymentCertificate          IDDocType = "EMPLOYMENT_CERTIFICATE"
+	IDDocTypeAgreement                      IDDocType = "AGREEMENT"
+	IDDocTypeContract                       IDDocType = "CONTRACT"
+	IDDocTypeInvestorDoc                    IDDocType = "INVESTOR_DOC"
+	IDDocTypeVehicleRegistrationCertificate IDDocType = "VEHICLE_REGISTRATION_CERTIFICATE"
+	IDDocTypeCovidVaccinationForm           IDDocType = "COVID_VACCINATION_FORM"
+	IDDocTypeOther                          IDDocType = "OTHER"
+)
+
+var idDocTypes = map[IDDocType]struct{}{
+	IDDocTypePassport:                       {},
+	IDDocTypeIDCard:                         {},
+	IDDocTypeDrivers:                        {},
+	IDDocTypeDriversTranslation:             {},
+	IDDocTypeResidencePermit:                {},
+	IDDocTypeSelfie:                         {},
+	IDDocTypeVideoSelfie:                    {},
+	IDDocTypeProfileImage:                   {},
+	IDDocTypeIDDocPhoto:                     {},
+	IDDocTypeUtilityBill:                    {},
+	IDDocTypeUtilityBill2:                   {},
+	IDDocTypeBankStatement:                  {},
+	IDDocTypeBankCard:                       {},
+	IDDocTypePaymentMethod:                  {},
+	IDDocTypeIncomeSource:                   {},
+	IDDocTypeEmploymentCertificate:          {},
+	IDDocTypeAgreement:                      {},
+	IDDocTypeContract:                       {},
+	IDDocTypeInvestorDoc:                    {},
+	IDDocTypeVehicleRegistrationCertificate: {},
+	IDDocTypeCovidVaccinationForm:           {},
+	IDDocTypeOther:                          {},
+}
+
+// IsKnown reports whether the document type is one of the documented values.
+func (t IDDocType) IsKnown() bool {
+	_, ok := idDocTypes[t]
+	return ok
+}
+
+// IsIdentity reports whether the document proves the identity of the applicant.
+func (t IDDocType) IsIdentity() bool {
+	switch t {
+	case IDDocTypePassport, IDDocTypeIDCard, IDDocTypeDrivers, IDDocTypeResidencePermit:
+		return true
+	}
+	return false
+}
+
+// Validate returns an error if the document type is unknown.
+func (t IDDocType) Validate() error {
+	if !t.IsKnown() {
+		return fmt.Errorf("invalid id doc type: %s", t)
+	}
+	return nil
+}
+
+func (t IDDocType) String() string {
+	return string(t)
+}
@@ -0,0 +1,14 @@
+package sumsub
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIDDocType(t *testing.T) {
+	assert.NoError(t, IDDocTypePassport.Validate())
+	assert.True(t, IDDocTypeResidencePermit.IsIdentity())
+	assert.False(t, IDDocTypeSelfie.IsIdentity())
+	assert.EqualError(t, IDDocType("passport").Validate(), "invalid id doc type: passport")
+}
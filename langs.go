@@ -1,5 +1,11 @@
 package sumsub
 
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
 const (
 	LangArabic              = "ar"
 	LangArmenian            = "hy"
@@ -52,3 +58,146 @@ const (
 	LangVietnamese          = "vi"
 	LangZulu                = "zu"
 )
+
+// langs registry of the languages supported by SumSub.
+var langs = map[string]struct{}{
+	LangArabic:              {},
+	LangArmenian:            {},
+	LangAzerbaijani:         {},
+	LangBengali:             {},
+	LangBulgarian:           {},
+	LangBurmese:             {},
+	LangCentralKhmer:        {},
+	LangChineseSimplified:   {},
+	LangChineseTraditional:  {},
+	LangCzech:               {},
+	LangDanish:              {},
+	LangDutch:               {},
+	LangEnglish:             {},
+	LangEstonian:            {},
+	LangFilipino:            {},
+	LangFrench:              {},
+	LangGeorgian:            {},
+	LangGerman:              {},
+	LangGreek:               {},
+	LangHausa:               {},
+	LangHindi:               {},
+	LangHungarian:           {},
+	LangIndonesian:          {},
+	LangItalian:             {},
+	LangJapanese:            {},
+	LangKazakh:              {},
+	LangKorean:              {},
+	LangLao:                 {},
+	LangLatvian:             {},
+	LangLithuanian:          {},
+	LangMalay:               {},
+	LangNorwegian:           {},
+	LangPersian:             {},
+	LangPolish:              {},
+	LangPortuguese:          {},
+	LangPortugueseBrazilian: {},
+	LangRomanian:            {},
+	LangRussian:             {},
+	LangSerbianLatin:        {},
+	LangSlovak:              {},
+	LangSpanish:             {},
+	LangSwahili:             {},
+	LangSwedish:             {},
+	LangTurkish:             {},
+	LangThai:                {},
+	LangUkrainian:           {},
+	LangUrdu:                {},
+	LangUzbek:               {},
+	LangVietnamese:          {},
+	LangZulu:                {},
+}
+
+// langAliases maps language tags to supported codes when the base language is not enough.
+var langAliases = map[string]string{
+	"zh-hant": LangChineseTraditional,
+	"zh-hk":   LangChineseTraditional,
+	"zh-mo":   LangChineseTraditional,
+	"zh-hans": LangChineseSimplified,
+	"zh-cn":   LangChineseSimplified,
+	"zh-sg":   LangChineseSimplified,
+	"fil":     LangFilipino,
+	"tl":      LangFilipino,
+	"nb":      LangNorwegian,
+	"nn":      LangNorwegian,
+}
+
+// Langs returns all supported language codes sorted.
+func Langs() []string {
+	res := make([]string, 0, len(langs))
+	for l := range langs {
+		res = append(res, l)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// IsSupportedLang reports whether the lang is one of Lang* constants.
+func IsSupportedLang(lang string) bool {
+	_, ok := langs[lang]
+	return ok
+}
+
+// NegotiateLang returns the best supported language for the HTTP Accept-Language header value,
+// e.g. "pt-BR,pt;q=0.9,en;q=0.8" gives LangPortugueseBrazilian. Returns fallback if nothing matches.
+func NegotiateLang(acceptLanguage, fallback string) string {
+	type tag struct {
+		lang string
+		q    float64
+	}
+	var tags []tag
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		lang, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		lang = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(lang), "_", "-"))
+		if lang == "" || lang == "*" {
+			continue
+		}
+		q := 1.0
+		for _, p := range strings.Split(params, ";") {
+			k, v, ok := strings.Cut(strings.TrimSpace(p), "=")
+			if !ok || strings.TrimSpace(k) != "q" {
+				continue
+			}
+			f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			if err != nil {
+				f = 0
+			}
+			q = f
+		}
+		if q <= 0 {
+			continue
+		}
+		tags = append(tags, tag{lang: lang, q: q})
+	}
+	sort.SliceStable(tags, func(i, j int) bool {
+		return tags[i].q > tags[j].q
+	})
+	for _, t := range tags {
+		if l, ok := matchLang(t.lang); ok {
+			return l
+		}
+	}
+	return fallback
+}
+
+func matchLang(tag string) (string, bool) {
+	for {
+		if IsSupportedLang(tag) {
+			return tag, true
+		}
+		if l, ok := langAliases[tag]; ok {
+			return l, true
+		}
+		// drop the last subtag: zh-hant-tw -> zh-hant -> zh
+		i := strings.LastIndex(tag, "-")
+		if i < 0 {
+			return "", false
+		}
+		tag = tag[:i]
+	}
+}
@@ -0,0 +1,45 @@
+package sumsub
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNegotiateLang(t *testing.T) {
+	cases := []struct {
+		header string
+		want   string
+	}{
+		{header: "", want: LangEnglish},
+		{header: "*", want: LangEnglish},
+		{header: "de", want: LangGerman},
+		{header: "pt-BR,pt;q=0.9,en;q=0.8", want: LangPortugueseBrazilian},
+		{header: "pt-PT,pt;q=0.9", want: LangPortuguese},
+		{header: "zh-TW", want: LangChineseTraditional},
+		{header: "zh-Hant-HK", want: LangChineseTraditional},
+		{header: "zh-CN,zh;q=0.9", want: LangChineseSimplified},
+		{header: "nb-NO", want: LangNorwegian},
+		{header: "xx,fr;q=0.5,de;q=0.7", want: LangGerman},
+		{header: "de;q=0,fr", want: LangFrench},
+		{header: "en_US", want: LangEnglish},
+		{header: "xx-YY", want: LangEnglish},
+	}
+	for _, c := range cases {
+		t.Run(c.header, func(t *testing.T) {
+			assert.Equal(t, c.want, NegotiateLang(c.header, LangEnglish))
+		})
+	}
+}
+
+func TestLangsRegistry(t *testing.T) {
+	assert.True(t, IsSupportedLang(LangPortugueseBrazilian))
+	assert.False(t, IsSupportedLang("pt-BR"))
+	assert.Contains(t, Langs(), LangZulu)
+
+	cli := NewClient("token", NewHMACSigner("secret"))
+	_, err := cli.GenerateExternalWebSDKLink(context.Background(), GenerateExternalWebSDKLinkRequest{Lang: "klingon"})
+	require.EqualError(t, err, "unsupported lang: klingon")
+}
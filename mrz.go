@@ -0,0 +1,361 @@
+package sumsub
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MRZFormat ICAO 9303 machine readable zone format.
+type MRZFormat string
+
+const (
+	MRZFormatTD1 MRZFormat = "TD1" // 3 lines of 30 characters, ID cards.
+	MRZFormatTD2 MRZFormat = "TD2" // 2 lines of 36 characters, ID cards and visas.
+	MRZFormatTD3 MRZFormat = "TD3" // 2 lines of 44 characters, passports.
+)
+
+// MRZ field names used in check digit errors and mismatches.
+const (
+	MRZFieldDocumentNumber = "documentNumber"
+	MRZFieldDOB            = "dob"
+	MRZFieldExpiry         = "expiry"
+	MRZFieldPersonalNumber = "personalNumber"
+	MRZFieldComposite      = "composite"
+	MRZFieldIssuingState   = "issuingState"
+	MRZFieldNationality    = "nationality"
+	MRZFieldFirstName      = "firstName"
+	MRZFieldLastName       = "lastName"
+)
+
+var ErrMRZNotFound = errors.New("mrz not found")
+
+type (
+	// MRZ decoded machine readable zone of the identity document.
+	MRZ struct {
+		Format         MRZFormat
+		DocumentCode   string
+		IssuingState   Country
+		DocumentNumber string
+		Nationality    Country
+		DOB            time.Time
+		Sex            string
+		Expiry         time.Time
+		LastName       string
+		FirstName      string
+		OptionalData1  string
+		OptionalData2  string
+		// InvalidCheckDigits names of the fields (MRZField*) with failed check digits.
+		InvalidCheckDigits []string
+	}
+
+	// MRZMismatch difference between the MRZ and the data extracted by SumSub.
+	MRZMismatch struct {
+		Field  string // one of MRZField* constants
+		Source string // "checkDigit", "idDoc" or "info"
+		MRZ    string // value from the MRZ
+		Value  string // value from the source, empty for check digit errors
+	}
+
+	// MRZReport result of the MRZ cross-validation.
+	MRZReport struct {
+		MRZ        MRZ
+		Mismatches []MRZMismatch
+	}
+
+	mrzOptions struct {
+		NowFunc NowFunc
+	}
+
+	MRZOpt func(*mrzOptions)
+)
+
+const (
+	MRZMismatchSourceCheckDigit = "checkDigit"
+	MRZMismatchSourceIDDoc      = "idDoc"
+	MRZMismatchSourceInfo       = "info"
+)
+
+// WithMRZNowFunc clock used to resolve the century of 2-digit birth years, time.Now by default.
+func WithMRZNowFunc(f NowFunc) MRZOpt {
+	return func(opts *mrzOptions) {
+		opts.NowFunc = f
+	}
+}
+
+// ParseMRZ decodes TD1, TD2 or TD3 machine readable zone.
+// Check digit failures are not errors, see MRZ.InvalidCheckDigits.
+func ParseMRZ(lines ...string) (MRZ, error) {
+	return ParseMRZLines(lines)
+}
+
+// ParseMRZLines same as ParseMRZ with options.
+func ParseMRZLines(lines []string, opts ...MRZOpt) (MRZ, error) {
+	o := mrzOptions{
+		NowFunc: time.Now,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	now := o.NowFunc()
+
+	var ls []string
+	for _, l := range lines {
+		l = strings.ToUpper(strings.TrimSpace(l))
+		if l != "" {
+			ls = append(ls, l)
+		}
+	}
+	if len(ls) == 0 {
+		return MRZ{}, ErrMRZNotFound
+	}
+	for _, l := range ls {
+		for _, r := range l {
+			if (r < '0' || r > '9') && (r < 'A' || r > 'Z') && r != '<' {
+				return MRZ{}, fmt.Errorf("mrz: invalid character: %q", r)
+			}
+		}
+	}
+
+	switch {
+	case len(ls) == 3 && len(ls[0]) == 30 && len(ls[1]) == 30 && len(ls[2]) == 30:
+		return parseMRZTD1(now, ls[0], ls[1], ls[2])
+	case len(ls) == 2 && len(ls[0]) == 36 && len(ls[1]) == 36:
+		return parseMRZTD2(now, ls[0], ls[1])
+	case len(ls) == 2 && len(ls[0]) == 44 && len(ls[1]) == 44:
+		return parseMRZTD3(now, ls[0], ls[1])
+	default:
+		return MRZ{}, errors.New("mrz: unknown format")
+	}
+}
+
+func parseMRZTD1(now time.Time, l1, l2, l3 string) (MRZ, error) {
+	m := MRZ{
+		Format:       MRZFormatTD1,
+		DocumentCode: mrzText(l1[0:2]),
+		IssuingState: mrzCountry(l1[2:5]),
+		Sex:          mrzText(l2[7:8]),
+		Nationality:  mrzCountry(l2[15:18]),
+	}
+	m.LastName, m.FirstName = mrzNames(l3)
+
+	number, numberCheck, optional := l1[5:14], l1[14], l1[15:30]
+	if numberCheck == '<' {
+		// long document number continues in the optional data, the last character is the check digit
+		if i := strings.IndexByte(optional, '<'); i > 0 {
+			number += optional[:i-1]
+			numberCheck = optional[i-1]
+			optional = optional[i:]
+		}
+	}
+	m.DocumentNumber = mrzText(number)
+	m.OptionalData1 = mrzText(optional)
+	m.OptionalData2 = mrzText(l2[18:29])
+	m.checkDigit(MRZFieldDocumentNumber, number, numberCheck)
+
+	if err := m.dates(now, l2[0:6], l2[6], l2[8:14], l2[14]); err != nil {
+		return MRZ{}, err
+	}
+	m.checkDigit(MRZFieldComposite, l1[5:30]+l2[0:7]+l2[8:15]+l2[18:29], l2[29])
+	return m, nil
+}
+
+func parseMRZTD2(now time.Time, l1, l2 string) (MRZ, error) {
+	m := MRZ{
+		Format:         MRZFormatTD2,
+		DocumentCode:   mrzText(l1[0:2]),
+		IssuingState:   mrzCountry(l1[2:5]),
+		DocumentNumber: mrzText(l2[0:9]),
+		Nationality:    mrzCountry(l2[10:13]),
+		Sex:            mrzText(l2[20:21]),
+		OptionalData1:  mrzText(l2[28:35]),
+	}
+	m.LastName, m.FirstName = mrzNames(l1[5:36])
+	m.checkDigit(MRZFieldDocumentNumber, l2[0:9], l2[9])
+	if err := m.dates(now, l2[13:19], l2[19], l2[21:27], l2[27]); err != nil {
+		return MRZ{}, err
+	}
+	m.checkDigit(MRZFieldComposite, l2[0:10]+l2[13:20]+l2[21:35], l2[35])
+	return m, nil
+}
+
+func parseMRZTD3(now time.Time, l1, l2 string) (MRZ, error) {
+	m := MRZ{
+		Format:         MRZFormatTD3,
+		DocumentCode:   mrzText(l1[0:2]),
+		IssuingState:   mrzCountry(l1[2:5]),
+		DocumentNumber: mrzText(l2[0:9]),
+		Nationality:    mrzCountry(l2[10:13]),
+		Sex:            mrzText(l2[20:21]),
+		OptionalData1:  mrzText(l2[28:42]),
+	}
+	m.LastName, m.FirstName = mrzNames(l1[5:44])
+	m.checkDigit(MRZFieldDocumentNumber, l2[0:9], l2[9])
+	if err := m.dates(now, l2[13:19], l2[19], l2[21:27], l2[27]); err != nil {
+		return MRZ{}, err
+	}
+	// personal number check digit could be filler when personal number is empty
+	if l2[42] != '<' || strings.Trim(l2[28:42], "<") != "" {
+		m.checkDigit(MRZFieldPersonalNumber, l2[28:42], l2[42])
+	}
+	m.checkDigit(MRZFieldComposite, l2[0:10]+l2[13:20]+l2[21:43], l2[43])
+	return m, nil
+}
+
+func (m *MRZ) dates(now time.Time, dob string, dobCheck byte, expiry string, expiryCheck byte) error {
+	var err error
+	if m.DOB, err = mrzDate(now, dob, true); err != nil {
+		return fmt.Errorf("mrz: dob: %w", err)
+	}
+	if m.Expiry, err = mrzDate(now, expiry, false); err != nil {
+		return fmt.Errorf("mrz: expiry: %w", err)
+	}
+	m.checkDigit(MRZFieldDOB, dob, dobCheck)
+	m.checkDigit(MRZFieldExpiry, expiry, expiryCheck)
+	return nil
+}
+
+func (m *MRZ) checkDigit(field, value string, check byte) {
+	if mrzCheckDigit(value) != check {
+		m.InvalidCheckDigits = append(m.InvalidCheckDigits, field)
+	}
+}
+
+// Valid reports whether all check digits are correct.
+func (m MRZ) Valid() bool {
+	return len(m.InvalidCheckDigits) == 0
+}
+
+// MRZ decodes the machine readable zone of the document.
+func (d IDDoc) MRZ(opts ...MRZOpt) (MRZ, error) {
+	return ParseMRZLines([]string{d.MRZLine1, d.MRZLine2, d.MRZLine3}, opts...)
+}
+
+// CrossCheckMRZ decodes the MRZ of the document and compares it with the document fields
+// and with the applicant info (could be empty). Only non-empty values are compared.
+func CrossCheckMRZ(doc IDDoc, info Info, opts ...MRZOpt) (MRZReport, error) {
+	m, err := doc.MRZ(opts...)
+	if err != nil {
+		return MRZReport{}, err
+	}
+	r := MRZReport{MRZ: m}
+	for _, f := range m.InvalidCheckDigits {
+		r.Mismatches = append(r.Mismatches, MRZMismatch{Field: f, Source: MRZMismatchSourceCheckDigit})
+	}
+
+	r.compareText(MRZMismatchSourceIDDoc, MRZFieldDocumentNumber, m.DocumentNumber, doc.Number)
+	r.compareText(MRZMismatchSourceIDDoc, MRZFieldIssuingState, string(m.IssuingState), string(doc.Country))
+	r.compareDate(MRZMismatchSourceIDDoc, MRZFieldDOB, m.DOB, doc.DOB)
+	r.compareDate(MRZMismatchSourceIDDoc, MRZFieldExpiry, m.Expiry, doc.ValidUntil)
+	r.compareName(MRZMismatchSourceIDDoc, MRZFieldLastName, m.LastName, doc.LastNameEn, doc.LastName)
+	r.compareName(MRZMismatchSourceIDDoc, MRZFieldFirstName, m.FirstName, doc.FirstNameEn, doc.FirstName)
+
+	r.compareText(MRZMismatchSourceInfo, MRZFieldNationality, string(m.Nationality), string(info.Nationality))
+	r.compareDate(MRZMismatchSourceInfo, MRZFieldDOB, m.DOB, info.DOB)
+	r.compareName(MRZMismatchSourceInfo, MRZFieldLastName, m.LastName, info.LastNameEn, info.LastName)
+	r.compareName(MRZMismatchSourceInfo, MRZFieldFirstName, m.FirstName, info.FirstNameEn, info.FirstName)
+	return r, nil
+}
+
+// Valid reports whether no mismatches were found.
+func (r MRZReport) Valid() bool {
+	return len(r.Mismatches) == 0
+}
+
+func (r *MRZReport) compareText(source, field, mrz, value string) {
+	if mrz == "" || value == "" {
+		return
+	}
+	if mrzNormalize(mrz) != mrzNormalize(value) {
+		r.Mismatches = append(r.Mismatches, MRZMismatch{Field: field, Source: source, MRZ: mrz, Value: value})
+	}
+}
+
+func (r *MRZReport) compareDate(source, field string, mrz, value time.Time) {
+	if mrz.IsZero() || value.IsZero() {
+		return
+	}
+	const layout = "2006-01-02"
+	if mrz.Format(layout) != value.UTC().Format(layout) {
+		r.Mismatches = append(r.Mismatches, MRZMismatch{Field: field, Source: source, MRZ: mrz.Format(layout), Value: value.Format(layout)})
+	}
+}
+
+// compareName compares MRZ name with the latin (preferred) or original spelling, MRZ names could be truncated.
+func (r *MRZReport) compareName(source, field, mrz, latin, original string) {
+	value := latin
+	if value == "" {
+		value = original
+	}
+	if mrz == "" || value == "" {
+		return
+	}
+	m, v := mrzNormalize(mrz), mrzNormalize(value)
+	if m != v && !strings.HasPrefix(v, m) {
+		r.Mismatches = append(r.Mismatches, MRZMismatch{Field: field, Source: source, MRZ: mrz, Value: value})
+	}
+}
+
+// mrzCheckDigit ICAO 9303 check digit: weights 7, 3, 1, letters A-Z as 10-35, filler as 0.
+func mrzCheckDigit(s string) byte {
+	weights := [3]int{7, 3, 1}
+	sum := 0
+	for i := 0; i < len(s); i++ {
+		var v int
+		switch c := s[i]; {
+		case c >= '0' && c <= '9':
+			v = int(c - '0')
+		case c >= 'A' && c <= 'Z':
+			v = int(c-'A') + 10
+		}
+		sum += v * weights[i%3]
+	}
+	return byte('0' + sum%10)
+}
+
+// mrzDate parses YYMMDD, birth dates are not after now.
+func mrzDate(now time.Time, s string, birth bool) (time.Time, error) {
+	if strings.Trim(s, "<") == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse("060102", s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date: %s", s)
+	}
+	// time.Parse maps 69-99 to 19xx and 00-68 to 20xx
+	if birth && t.After(now) {
+		t = t.AddDate(-100, 0, 0)
+	}
+	return t, nil
+}
+
+func mrzNames(s string) (last, first string) {
+	last, first, _ = strings.Cut(strings.TrimRight(s, "<"), "<<")
+	return mrzText(last), mrzText(first)
+}
+
+func mrzText(s string) string {
+	return strings.Join(strings.FieldsFunc(s, func(r rune) bool { return r == '<' }), " ")
+}
+
+func mrzCountry(s string) Country {
+	c := mrzText(s)
+	if c == "D" { // Germany uses a single letter code
+		return "DEU"
+	}
+	return Country(c)
+}
+
+// mrzNormalize uppercases and drops everything except latin letters and digits.
+func mrzNormalize(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= '0' && r <= '9', r >= 'A' && r <= 'Z':
+			return r
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		}
+		return -1
+	}, s)
+}
@@ -0,0 +1,187 @@
+package sumsub
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMRZ(t *testing.T) {
+	cases := []struct {
+		name  string
+		lines []string
+		want  MRZ
+	}{
+		{
+			name: "TD3",
+			lines: []string{
+				"P<UTOERIKSSON<<ANNA<MARIA<<<<<<<<<<<<<<<<<<<",
+				"L898902C36UTO7408122F1204159ZE184226B<<<<<10",
+			},
+			want: MRZ{
+				Format:         MRZFormatTD3,
+				DocumentCode:   "P",
+				IssuingState:   "UTO",
+				DocumentNumber: "L898902C3",
+				Nationality:    "UTO",
+				DOB:            time.Date(1974, 8, 12, 0, 0, 0, 0, time.UTC),
+				Sex:            "F",
+				Expiry:         time.Date(2012, 4, 15, 0, 0, 0, 0, time.UTC),
+				LastName:       "ERIKSSON",
+				FirstName:      "ANNA MARIA",
+				OptionalData1:  "ZE184226B",
+			},
+		},
+		{
+			name: "TD2",
+			lines: []string{
+				"I<UTOERIKSSON<<ANNA<MARIA<<<<<<<<<<<",
+				"D231458907UTO7408122F1204159<<<<<<<6",
+			},
+			want: MRZ{
+				Format:         MRZFormatTD2,
+				DocumentCode:   "I",
+				IssuingState:   "UTO",
+				DocumentNumber: "D23145890",
+				Nationality:    "UTO",
+				DOB:            time.Date(1974, 8, 12, 0, 0, 0, 0, time.UTC),
+				Sex:            "F",
+				Expiry:         time.Date(2012, 4, 15, 0, 0, 0, 0, time.UTC),
+				LastName:       "ERIKSSON",
+				FirstName:      "ANNA MARIA",
+			},
+		},
+		{
+			name: "TD1",
+			lines: []string{
+				"I<UTOD231458907<<<<<<<<<<<<<<<",
+				"7408122F1204159UTO<<<<<<<<<<<6",
+				"ERIKSSON<<ANNA<MARIA<<<<<<<<<<",
+			},
+			want: MRZ{
+				Format:         MRZFormatTD1,
+				DocumentCode:   "I",
+				IssuingState:   "UTO",
+				DocumentNumber: "D23145890",
+				Nationality:    "UTO",
+				DOB:            time.Date(1974, 8, 12, 0, 0, 0, 0, time.UTC),
+				Sex:            "F",
+				Expiry:         time.Date(2012, 4, 15, 0, 0, 0, 0, time.UTC),
+				LastName:       "ERIKSSON",
+				FirstName:      "ANNA MARIA",
+			},
+		},
+		{
+			name: "TD1 long document number",
+			lines: []string{
+				"I<UTOD23145890<7349<<<<<<<<<<<",
+				"7408122F1204159UTO<<<<<<<<<<<6",
+				"ERIKSSON<<ANNA<MARIA<<<<<<<<<<",
+			},
+			want: MRZ{
+				Format:         MRZFormatTD1,
+				DocumentCode:   "I",
+				IssuingState:   "UTO",
+				DocumentNumber: "D23145890734",
+				Nationality:    "UTO",
+				DOB:            time.Date(1974, 8, 12, 0, 0, 0, 0, time.UTC),
+				Sex:            "F",
+				Expiry:         time.Date(2012, 4, 15, 0, 0, 0, 0, time.UTC),
+				LastName:       "ERIKSSON",
+				FirstName:      "ANNA MARIA",
+			},
+		},
+		{
+			name: "TD1 Germany",
+			lines: []string{
+				"IDD<<LGXX359T81<<<<<<<<<<<<<<<",
+				"8907161<2809045D<<<<<<<<<<<<<2",
+				"SMITH<<CHRISTIAN<<<<<<<<<<<<<<",
+			},
+			want: MRZ{
+				Format:         MRZFormatTD1,
+				DocumentCode:   "ID",
+				IssuingState:   "DEU",
+				DocumentNumber: "LGXX359T8",
+				Nationality:    "DEU",
+				DOB:            time.Date(1989, 7, 16, 0, 0, 0, 0, time.UTC),
+				Expiry:         time.Date(2028, 9, 4, 0, 0, 0, 0, time.UTC),
+				LastName:       "SMITH",
+				FirstName:      "CHRISTIAN",
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			m, err := ParseMRZ(c.lines...)
+			require.NoError(t, err)
+			assert.Equal(t, c.want, m)
+			assert.True(t, m.Valid())
+		})
+	}
+}
+
+func TestParseMRZErrors(t *testing.T) {
+	_, err := ParseMRZ("", "")
+	assert.ErrorIs(t, err, ErrMRZNotFound)
+
+	_, err = ParseMRZ("P<UTO", "L898902C36")
+	assert.EqualError(t, err, "mrz: unknown format")
+
+	_, err = ParseMRZ("P<UTOERIKSSON<<ANNA<MARIA<<<<<<<<<<<<<<<<<<<", "L898902C36UTO7413122F1204159ZE184226B<<<<<10")
+	assert.EqualError(t, err, "mrz: dob: invalid date: 741312")
+
+	m, err := ParseMRZ("P<UTOERIKSSON<<ANNA<MARIA<<<<<<<<<<<<<<<<<<<", "L898902C46UTO7408122F1204159ZE184226B<<<<<10")
+	require.NoError(t, err)
+	assert.False(t, m.Valid())
+	assert.Equal(t, []string{MRZFieldDocumentNumber, MRZFieldComposite}, m.InvalidCheckDigits)
+
+	// birth year century is resolved against the clock
+	m, err = ParseMRZLines([]string{"P<UTOERIKSSON<<ANNA<MARIA<<<<<<<<<<<<<<<<<<<", "L898902C36UTO7408122F1204159ZE184226B<<<<<10"},
+		WithMRZNowFunc(func() time.Time { return time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC) }))
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(1874, 8, 12, 0, 0, 0, 0, time.UTC), m.DOB)
+}
+
+func TestCrossCheckMRZ(t *testing.T) {
+	doc := IDDoc{
+		IDDocType:   IDDocTypeIDCard,
+		Country:     "DEU",
+		FirstName:   "CHRISTIAN",
+		FirstNameEn: "CHRISTIAN",
+		LastName:    "SMITH",
+		LastNameEn:  "SMITH",
+		ValidUntil:  time.Date(2028, 9, 4, 0, 0, 0, 0, time.UTC),
+		Number:      "LGXX359T8",
+		DOB:         time.Date(1989, 7, 16, 0, 0, 0, 0, time.UTC),
+		MRZLine1:    "IDD<<LGXX359T81<<<<<<<<<<<<<<<",
+		MRZLine2:    "8907161<2809045D<<<<<<<<<<<<<2",
+		MRZLine3:    "SMITH<<CHRISTIAN<<<<<<<<<<<<<<",
+	}
+	info := Info{
+		FirstNameEn: "Christian",
+		LastNameEn:  "Smith",
+		DOB:         time.Date(1989, 7, 16, 0, 0, 0, 0, time.UTC),
+		Nationality: "DEU",
+	}
+
+	r, err := CrossCheckMRZ(doc, info)
+	require.NoError(t, err)
+	assert.True(t, r.Valid())
+
+	doc.Number = "LGXX359T9"
+	info.DOB = time.Date(1989, 7, 17, 0, 0, 0, 0, time.UTC)
+	info.Nationality = "AUT"
+	r, err = CrossCheckMRZ(doc, info)
+	require.NoError(t, err)
+	assert.Equal(t, []MRZMismatch{
+		{Field: MRZFieldDocumentNumber, Source: MRZMismatchSourceIDDoc, MRZ: "LGXX359T8", Value: "LGXX359T9"},
+		{Field: MRZFieldNationality, Source: MRZMismatchSourceInfo, MRZ: "DEU", Value: "AUT"},
+		{Field: MRZFieldDOB, Source: MRZMismatchSourceInfo, MRZ: "1989-07-16", Value: "1989-07-17"},
+	}, r.Mismatches)
+
+	_, err = CrossCheckMRZ(IDDoc{}, info)
+	assert.ErrorIs(t, err, ErrMRZNotFound)
+}
@@ -0,0 +1,203 @@
+package sumsub
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+const defaultPageSize = 100
+
+type (
+	// Page one page of the list endpoint.
+	Page[T any] struct {
+		Items      []T
+		TotalItems int // 0 if the endpoint does not report it
+	}
+
+	// PageFunc fetches the page starting at offset with at most limit items.
+	PageFunc[T any] func(ctx context.Context, offset, limit int) (Page[T], error)
+
+	// Paginator walks offset/limit pages of the list endpoint.
+	// Pages are fetched one by one, or up to Prefetch pages ahead in parallel once the total is known.
+	// Not safe for concurrent use.
+	Paginator[T any] struct {
+		fetch    PageFunc[T]
+		limit    int
+		prefetch int
+		offset   int // offset of the next page to fetch
+		total    int
+		buf      []T
+		pending  []pendingPage[T]
+		done     bool // no more pages to fetch
+		err      error
+	}
+
+	paginatorOptions struct {
+		Offset   int
+		PageSize int
+		Prefetch int
+	}
+
+	PaginatorOpt func(*paginatorOptions)
+
+	pendingPage[T any] struct {
+		ctx    context.Context // context the page is fetched with
+		offset int
+		ch     chan pageResult[T]
+	}
+
+	pageResult[T any] struct {
+		page Page[T]
+		err  error
+	}
+)
+
+func NewPaginator[T any](fetch PageFunc[T], opts ...PaginatorOpt) *Paginator[T] {
+	o := paginatorOptions{
+		PageSize: defaultPageSize,
+		Prefetch: 1,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.PageSize <= 0 {
+		o.PageSize = defaultPageSize
+	}
+	if o.Prefetch <= 0 {
+		o.Prefetch = 1
+	}
+	return &Paginator[T]{
+		fetch:    fetch,
+		limit:    o.PageSize,
+		prefetch: o.Prefetch,
+		offset:   o.Offset,
+	}
+}
+
+// WithOffset starts the pagination from the offset.
+func WithOffset(offset int) PaginatorOpt {
+	return func(opts *paginatorOptions) {
+		opts.Offset = offset
+	}
+}
+
+// WithPageSize limit of the single page request.
+func WithPageSize(size int) PaginatorOpt {
+	return func(opts *paginatorOptions) {
+		opts.PageSize = size
+	}
+}
+
+// WithPrefetch max number of pages fetched in parallel, pages are still returned in order.
+func WithPrefetch(n int) PaginatorOpt {
+	return func(opts *paginatorOptions) {
+		opts.Prefetch = n
+	}
+}
+
+// Next returns the next item, io.EOF when all items are read.
+// Fetch errors are sticky, context errors are not: the failed pages are fetched again on the next call.
+func (p *Paginator[T]) Next(ctx context.Context) (T, error) {
+	var zero T
+	for len(p.buf) == 0 {
+		if p.err != nil {
+			return zero, p.err
+		}
+		if p.done && len(p.pending) == 0 {
+			return zero, io.EOF
+		}
+		if err := ctx.Err(); err != nil {
+			return zero, err
+		}
+		p.schedule(ctx)
+		if len(p.pending) == 0 {
+			continue
+		}
+
+		var res pageResult[T]
+		select {
+		case res = <-p.pending[0].ch:
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		}
+		pp := p.pending[0]
+		p.pending = p.pending[1:]
+		if res.err != nil {
+			if pp.ctx.Err() != nil && (errors.Is(res.err, context.Canceled) || errors.Is(res.err, context.DeadlineExceeded)) {
+				// prefetched with the context of the previous call, start over from the failed page
+				p.rewind(pp.offset)
+				continue
+			}
+			p.err = res.err
+			p.pending = nil
+			return zero, res.err
+		}
+		p.buf = res.page.Items
+		if res.page.TotalItems > 0 {
+			p.total = res.page.TotalItems
+		}
+		n := len(res.page.Items)
+		switch {
+		case n == 0, p.total > 0 && pp.offset+n >= p.total:
+			// last page, drop the pages prefetched after it
+			p.done = true
+			p.pending = nil
+		case n < p.limit:
+			// the server caps the page size, the prefetched pages leave gaps
+			p.limit = n
+			p.rewind(pp.offset + n)
+		}
+	}
+	item := p.buf[0]
+	p.buf = p.buf[1:]
+	return item, nil
+}
+
+// All reads all remaining items.
+func (p *Paginator[T]) All(ctx context.Context) ([]T, error) {
+	var res []T
+	for {
+		item, err := p.Next(ctx)
+		if err == io.EOF {
+			return res, nil
+		}
+		if err != nil {
+			return res, err
+		}
+		res = append(res, item)
+	}
+}
+
+// TotalItems total number of items as reported by the last fetched page, 0 if unknown.
+func (p *Paginator[T]) TotalItems() int {
+	return p.total
+}
+
+// schedule starts fetching of the next pages, parallel only when the total is known.
+func (p *Paginator[T]) schedule(ctx context.Context) {
+	ahead := 1
+	if p.total > 0 {
+		ahead = p.prefetch
+	}
+	for len(p.pending) < ahead && !p.done {
+		if p.total > 0 && p.offset >= p.total {
+			p.done = true
+			return
+		}
+		ch := make(chan pageResult[T], 1)
+		go func(offset, limit int) {
+			page, err := p.fetch(ctx, offset, limit)
+			ch <- pageResult[T]{page: page, err: err}
+		}(p.offset, p.limit)
+		p.pending = append(p.pending, pendingPage[T]{ctx: ctx, offset: p.offset, ch: ch})
+		p.offset += p.limit
+	}
+}
+
+// rewind drops the pending pages and continues fetching from the offset.
+func (p *Paginator[T]) rewind(offset int) {
+	p.pending = nil
+	p.offset = offset
+	p.done = false
+}
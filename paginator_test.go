@@ -0,0 +1,204 @@
+package sumsub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testPages returns PageFunc over total ints, reporting the total if withTotal.
+func testPages(total int, withTotal bool) PageFunc[int] {
+	return func(_ context.Context, offset, limit int) (Page[int], error) {
+		var page Page[int]
+		for i := offset; i < total && i < offset+limit; i++ {
+			page.Items = append(page.Items, i)
+		}
+		if withTotal {
+			page.TotalItems = total
+		}
+		return page, nil
+	}
+}
+
+func TestPaginator(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		total     int
+		withTotal bool
+		opts      []PaginatorOpt
+	}{
+		{name: "empty", total: 0},
+		{name: "single page", total: 3, withTotal: true},
+		{name: "exact pages without total", total: 6, opts: []PaginatorOpt{WithPageSize(2)}},
+		{name: "prefetch", total: 11, withTotal: true, opts: []PaginatorOpt{WithPageSize(2), WithPrefetch(3)}},
+		{name: "prefetch without total", total: 11, opts: []PaginatorOpt{WithPageSize(2), WithPrefetch(3)}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			items, err := NewPaginator(testPages(tc.total, tc.withTotal), tc.opts...).All(context.Background())
+			require.NoError(t, err)
+			require.Len(t, items, tc.total)
+			for i, v := range items {
+				assert.Equal(t, i, v)
+			}
+		})
+	}
+
+	items, err := NewPaginator(testPages(5, true), WithOffset(3), WithPageSize(1)).All(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []int{3, 4}, items)
+}
+
+func TestPaginator_PrefetchBound(t *testing.T) {
+	var (
+		mu             sync.Mutex
+		inFlight, peak int
+		calls          int32
+		fetch          = testPages(100, true)
+	)
+	p := NewPaginator(func(ctx context.Context, offset, limit int) (Page[int], error) {
+		atomic.AddInt32(&calls, 1)
+		mu.Lock()
+		inFlight++
+		if inFlight > peak {
+			peak = inFlight
+		}
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+		return fetch(ctx, offset, limit)
+	}, WithPageSize(10), WithPrefetch(4))
+
+	items, err := p.All(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, items, 100)
+	assert.Equal(t, 100, p.TotalItems())
+	assert.LessOrEqual(t, peak, 4)
+	assert.Equal(t, int32(10), atomic.LoadInt32(&calls))
+}
+
+func TestPaginator_Errors(t *testing.T) {
+	errFetch := errors.New("fetch")
+	fetch := testPages(10, true)
+	p := NewPaginator(func(ctx context.Context, offset, limit int) (Page[int], error) {
+		if offset >= 4 {
+			return Page[int]{}, errFetch
+		}
+		return fetch(ctx, offset, limit)
+	}, WithPageSize(2))
+
+	items, err := p.All(context.Background())
+	assert.ErrorIs(t, err, errFetch)
+	assert.Equal(t, []int{0, 1, 2, 3}, items)
+	_, err = p.Next(context.Background())
+	assert.ErrorIs(t, err, errFetch)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	p = NewPaginator(testPages(10, true))
+	_, err = p.Next(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+	v, err := p.Next(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, v)
+}
+
+func TestApplicantActionsPages(t *testing.T) {
+	cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/resources/applicantActions/-;applicantId=5b594ade0a975a36c9349e66", r.URL.Path)
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		var items []string
+		for i := offset; i < 5 && i < offset+limit; i++ {
+			items = append(items, fmt.Sprintf(`{"id":"act-%d"}`, i))
+		}
+		_, _ = fmt.Fprintf(w, `{"items":[%s],"totalItems":5}`, strings.Join(items, ","))
+	})
+
+	p := cli.ApplicantActionsPages("5b594ade0a975a36c9349e66", WithPageSize(2), WithPrefetch(2))
+	var ids []string
+	for {
+		act, err := p.Next(context.Background())
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		ids = append(ids, act.ID)
+	}
+	assert.Equal(t, []string{"act-0", "act-1", "act-2", "act-3", "act-4"}, ids)
+}
+
+func TestPaginator_CappedPageSize(t *testing.T) {
+	var mu sync.Mutex
+	var offsets []int
+	fetch := testPages(10, true)
+	p := NewPaginator(func(ctx context.Context, offset, limit int) (Page[int], error) {
+		mu.Lock()
+		offsets = append(offsets, offset)
+		mu.Unlock()
+		if limit > 3 {
+			limit = 3 // server side cap
+		}
+		return fetch(ctx, offset, limit)
+	}, WithPageSize(5), WithPrefetch(2))
+
+	items, err := p.All(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, items)
+	assert.Contains(t, offsets, 9)
+	assert.NotContains(t, offsets, 10)
+}
+
+func TestPaginator_ShortPageWithoutTotal(t *testing.T) {
+	fetch := testPages(7, false)
+	p := NewPaginator(func(ctx context.Context, offset, limit int) (Page[int], error) {
+		if limit > 3 {
+			limit = 3
+		}
+		return fetch(ctx, offset, limit)
+	}, WithPageSize(5))
+
+	items, err := p.All(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6}, items)
+}
+
+func TestPaginator_CancelThenResume(t *testing.T) {
+	var resumed atomic.Bool
+	fetch := testPages(6, true)
+	p := NewPaginator(func(ctx context.Context, offset, limit int) (Page[int], error) {
+		if offset > 0 && !resumed.Load() {
+			<-ctx.Done() // prefetched pages hang until the caller gives up
+			return Page[int]{}, fmt.Errorf("call: %w", ctx.Err())
+		}
+		return fetch(ctx, offset, limit)
+	}, WithPageSize(2), WithPrefetch(2))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	for i := 0; i < 2; i++ {
+		v, err := p.Next(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, i, v)
+	}
+	cancel()
+	_, err := p.Next(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	resumed.Store(true)
+	items, err := p.All(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []int{2, 3, 4, 5}, items)
+}
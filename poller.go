@@ -0,0 +1,253 @@
+package sumsub
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type (
+	// ReviewStatusChange notification of the poller, Old is the zero ReviewState if it was not known.
+	ReviewStatusChange struct {
+		ApplicantID string
+		Old         ReviewState
+		New         ReviewState
+		Status      ApplicantReviewStatusResponse
+		Final       bool // no more notifications for the applicant
+	}
+
+	// Poller periodically fetches the review status of applicants for the flows without webhooks.
+	// Applicants in progress (pending, prechecked, queued) are polled with the fast interval, others with the slow one.
+	// Polling of the applicant stops once it is completed with GREEN or FINAL RED answer.
+	Poller struct {
+		cli     *Client
+		fast    time.Duration
+		slow    time.Duration
+		onError func(applicantID string, err error)
+
+		mu      sync.Mutex
+		targets map[string]*pollTarget
+		wake    chan struct{}
+	}
+
+	pollerOptions struct {
+		FastInterval time.Duration
+		SlowInterval time.Duration
+		OnError      func(applicantID string, err error)
+	}
+
+	PollerOpt func(*pollerOptions)
+
+	pollTarget struct {
+		known ReviewState
+		next  time.Time
+	}
+)
+
+func NewPoller(cli *Client, opts ...PollerOpt) *Poller {
+	o := pollerOptions{
+		FastInterval: 5 * time.Second,
+		SlowInterval: time.Minute,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &Poller{
+		cli:     cli,
+		fast:    o.FastInterval,
+		slow:    o.SlowInterval,
+		onError: o.OnError,
+		targets: make(map[string]*pollTarget),
+		wake:    make(chan struct{}, 1),
+	}
+}
+
+// WithPollFastInterval interval for the applicants in progress.
+func WithPollFastInterval(d time.Duration) PollerOpt {
+	return func(opts *pollerOptions) {
+		opts.FastInterval = d
+	}
+}
+
+// WithPollSlowInterval interval for the applicants waiting for the user or compliance officer, and after errors.
+func WithPollSlowInterval(d time.Duration) PollerOpt {
+	return func(opts *pollerOptions) {
+		opts.SlowInterval = d
+	}
+}
+
+// WithPollErrorHandler called on every failed status request, the applicant is retried after the slow interval.
+func WithPollErrorHandler(f func(applicantID string, err error)) PollerOpt {
+	return func(opts *pollerOptions) {
+		opts.OnError = f
+	}
+}
+
+// Add starts polling of the applicant, known is the state the caller has (zero if unknown).
+// Safe to call while Run is in progress.
+func (p *Poller) Add(applicantID string, known ReviewState) {
+	p.mu.Lock()
+	p.targets[applicantID] = &pollTarget{known: known}
+	p.mu.Unlock()
+
+	select {
+	case p.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Remove stops polling of the applicant.
+func (p *Poller) Remove(applicantID string) {
+	p.mu.Lock()
+	delete(p.targets, applicantID)
+	p.mu.Unlock()
+}
+
+// Run polls until ctx is done and returns ctx error, with no applicants it waits for the next Add.
+// notify is called from the Run goroutine for every observed state change.
+func (p *Poller) Run(ctx context.Context, notify func(ReviewStatusChange)) error {
+	return p.run(ctx, notify, false)
+}
+
+// RunUntilDone same as Run, but returns nil once no applicants are left to poll, including at the start.
+func (p *Poller) RunUntilDone(ctx context.Context, notify func(ReviewStatusChange)) error {
+	return p.run(ctx, notify, true)
+}
+
+func (p *Poller) run(ctx context.Context, notify func(ReviewStatusChange), untilDone bool) error {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	armed := true
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+			armed = false
+		case <-p.wake:
+			if armed && !timer.Stop() {
+				<-timer.C
+			}
+			armed = false
+		}
+
+		next, ok := p.pollDue(ctx, notify)
+		if !ok {
+			if untilDone {
+				return nil
+			}
+			// idle until Add
+			continue
+		}
+		timer.Reset(time.Until(next))
+		armed = true
+	}
+}
+
+// Watch runs the poller in the background delivering changes through the channel.
+// The changes channel is closed when polling stops, then the Run result is sent to the error channel.
+func (p *Poller) Watch(ctx context.Context) (<-chan ReviewStatusChange, <-chan error) {
+	changes := make(chan ReviewStatusChange)
+	errc := make(chan error, 1)
+	go func() {
+		err := p.Run(ctx, func(ch ReviewStatusChange) {
+			select {
+			case changes <- ch:
+			case <-ctx.Done():
+			}
+		})
+		close(changes)
+		errc <- err
+	}()
+	return changes, errc
+}
+
+// pollDue polls the applicants which are due and returns the time of the next poll, false if nothing left to poll.
+func (p *Poller) pollDue(ctx context.Context, notify func(ReviewStatusChange)) (time.Time, bool) {
+	now := time.Now()
+	p.mu.Lock()
+	var due []string
+	for id, t := range p.targets {
+		if !t.next.After(now) {
+			due = append(due, id)
+		}
+	}
+	p.mu.Unlock()
+
+	for _, id := range due {
+		if ctx.Err() != nil {
+			break
+		}
+		p.poll(ctx, id, notify)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.targets) == 0 {
+		return time.Time{}, false
+	}
+	var next time.Time
+	for _, t := range p.targets {
+		if next.IsZero() || t.next.Before(next) {
+			next = t.next
+		}
+	}
+	return next, true
+}
+
+func (p *Poller) poll(ctx context.Context, applicantID string, notify func(ReviewStatusChange)) {
+	status, err := p.cli.ApplicantReviewStatus(ctx, ApplicantReviewStatusRequest{ApplicantID: applicantID})
+
+	p.mu.Lock()
+	t, ok := p.targets[applicantID]
+	if !ok {
+		// removed while polling
+		p.mu.Unlock()
+		return
+	}
+	if err != nil {
+		t.next = time.Now().Add(p.slow)
+		p.mu.Unlock()
+		if p.onError != nil && ctx.Err() == nil {
+			p.onError(applicantID, err)
+		}
+		return
+	}
+
+	actual := reviewStateOf(status)
+	final := isFinalReview(status)
+	change := ReviewStatusChange{
+		ApplicantID: applicantID,
+		Old:         t.known,
+		New:         actual,
+		Status:      status,
+		Final:       final,
+	}
+	changed := !actual.Equal(t.known)
+	t.known = actual
+	if final {
+		delete(p.targets, applicantID)
+	} else {
+		t.next = time.Now().Add(p.interval(status.ReviewStatus))
+	}
+	p.mu.Unlock()
+
+	if changed || final {
+		notify(change)
+	}
+}
+
+func (p *Poller) interval(status ReviewStatus) time.Duration {
+	if status.IsInProgress() {
+		return p.fast
+	}
+	return p.slow
+}
+
+// isFinalReview the review is completed and will not change without the client actions.
+func isFinalReview(status ApplicantReviewStatusResponse) bool {
+	if status.ReviewStatus != ReviewStatusCompleted {
+		return false
+	}
+	return status.ReviewResult.IsApproved() || status.ReviewResult.IsFinalRejected()
+}
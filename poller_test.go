@@ -0,0 +1,149 @@
+package sumsub
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoller_Run(t *testing.T) {
+	responses := map[string][]string{
+		"app-1": {
+			`{"reviewStatus":"pending"}`,
+			`{"reviewStatus":"pending"}`,
+			`{"reviewStatus":"queued"}`,
+			`{"reviewStatus":"completed","reviewResult":{"reviewAnswer":"GREEN"}}`,
+		},
+		"app-2": {
+			`{"reviewStatus":"completed","reviewResult":{"reviewAnswer":"RED","reviewRejectType":"RETRY"}}`,
+			`{"reviewStatus":"completed","reviewResult":{"reviewAnswer":"RED","reviewRejectType":"FINAL"}}`,
+		},
+	}
+	var mu sync.Mutex
+	cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		for id, resp := range responses {
+			if r.URL.Path != "/resources/applicants/"+id+"/status" {
+				continue
+			}
+			if len(resp) == 0 {
+				t.Errorf("polled after the final state: %s", id)
+				writeAPIError(w, http.StatusNotFound, 0)
+				return
+			}
+			_, _ = w.Write([]byte(resp[0]))
+			responses[id] = resp[1:]
+			return
+		}
+		writeAPIError(w, http.StatusNotFound, 0)
+	})
+
+	var errs []string
+	p := NewPoller(cli,
+		WithPollFastInterval(5*time.Millisecond),
+		WithPollSlowInterval(20*time.Millisecond),
+		WithPollErrorHandler(func(applicantID string, err error) { errs = append(errs, applicantID) }),
+	)
+	p.Add("app-1", ReviewState{})
+	p.Add("app-2", ReviewState{ReviewStatus: ReviewStatusPending})
+
+	var changes []ReviewStatusChange
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, p.RunUntilDone(ctx, func(ch ReviewStatusChange) {
+		changes = append(changes, ch)
+	}))
+
+	var app1, app2 []ReviewStatus
+	for _, ch := range changes {
+		switch ch.ApplicantID {
+		case "app-1":
+			app1 = append(app1, ch.New.ReviewStatus)
+		case "app-2":
+			app2 = append(app2, ch.New.ReviewStatus)
+		}
+	}
+	assert.Equal(t, []ReviewStatus{ReviewStatusPending, ReviewStatusQueued, ReviewStatusCompleted}, app1)
+	assert.Equal(t, []ReviewStatus{ReviewStatusCompleted, ReviewStatusCompleted}, app2)
+	last := changes[len(changes)-1]
+	assert.True(t, last.Final)
+	assert.Empty(t, errs)
+}
+
+func TestPoller_RunEmpty(t *testing.T) {
+	cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/resources/applicants/app-1/status", r.URL.Path)
+		_, _ = w.Write([]byte(`{"reviewStatus":"completed","reviewResult":{"reviewAnswer":"GREEN"}}`))
+	})
+	p := NewPoller(cli)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	assert.NoError(t, p.RunUntilDone(ctx, func(ReviewStatusChange) {}))
+
+	changes := make(chan ReviewStatusChange, 1)
+	errc := make(chan error, 1)
+	go func() {
+		errc <- p.Run(ctx, func(ch ReviewStatusChange) { changes <- ch })
+	}()
+
+	time.Sleep(10 * time.Millisecond) // Run is idle with no applicants
+	p.Add("app-1", ReviewState{})
+	select {
+	case ch := <-changes:
+		assert.Equal(t, "app-1", ch.ApplicantID)
+		assert.True(t, ch.Final)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no change after Add")
+	}
+
+	// still running with no applicants left
+	select {
+	case err := <-errc:
+		t.Fatalf("Run returned: %v", err)
+	case <-time.After(10 * time.Millisecond):
+	}
+	cancel()
+	assert.ErrorIs(t, <-errc, context.Canceled)
+}
+
+func TestPoller_Watch(t *testing.T) {
+	cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/resources/applicants/app-1/status" {
+			_, _ = w.Write([]byte(`{"reviewStatus":"onHold"}`))
+			return
+		}
+		writeAPIError(w, http.StatusNotFound, 0)
+	})
+
+	failed := make(chan string, 100)
+	p := NewPoller(cli,
+		WithPollFastInterval(5*time.Millisecond),
+		WithPollSlowInterval(5*time.Millisecond),
+		WithPollErrorHandler(func(applicantID string, err error) {
+			failed <- applicantID
+		}),
+	)
+	p.Add("app-1", ReviewState{})
+	p.Add("missing", ReviewState{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	changes, errc := p.Watch(ctx)
+
+	ch := <-changes
+	assert.Equal(t, "app-1", ch.ApplicantID)
+	assert.Equal(t, ReviewStatusOnHold, ch.New.ReviewStatus)
+	assert.False(t, ch.Final)
+	assert.Equal(t, "missing", <-failed)
+
+	cancel()
+	for range changes {
+	}
+	assert.ErrorIs(t, <-errc, context.Canceled)
+}
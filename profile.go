@@ -0,0 +1,235 @@
+package sumsub
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Environment SumSub environment the app token is issued for.
+type Environment string
+
+const (
+	EnvironmentSandbox    Environment = "sandbox"
+	EnvironmentProduction Environment = "production"
+)
+
+// Environment variables read by ProfileFromEnv, names are prefixed with the given prefix.
+const (
+	EnvSuffixEnvironment   = "_ENV"
+	EnvSuffixHost          = "_HOST"
+	EnvSuffixAppToken      = "_APP_TOKEN"
+	EnvSuffixSecretKey     = "_SECRET_KEY"
+	EnvSuffixWebhookSecret = "_WEBHOOK_SECRET"
+	// EnvSuffixAllowUnprefixedToken boolean, see Profile.AllowUnprefixedToken.
+	EnvSuffixAllowUnprefixedToken = "_ALLOW_UNPREFIXED_TOKEN"
+
+	DefaultEnvPrefix = "SUMSUB"
+)
+
+const (
+	tokenPrefixSandbox    = "sbx:"
+	tokenPrefixProduction = "prd:"
+)
+
+// ErrTokenEnvironmentMismatch the app token is issued for another environment or its environment is unknown,
+// SumSub answers with ErrCodeAppTokenNotFound in this case.
+var ErrTokenEnvironmentMismatch = errors.New("app token does not match environment")
+
+type (
+	// Profile credentials and settings of the single SumSub environment.
+	Profile struct {
+		Environment   Environment // inferred from the app token prefix if empty
+		Host          string      // Host if empty
+		AppToken      string
+		SecretKey     string
+		WebhookSecret string
+		// AllowUnprefixedToken accepts legacy app tokens without sbx: or prd: prefix,
+		// the environment could not be checked and must be set explicitly.
+		AllowUnprefixedToken bool
+	}
+
+	// Profiles named profiles, e.g. loaded from the file.
+	Profiles map[string]Profile
+
+	jsonProfile struct {
+		Environment          Environment `json:"environment"`
+		Host                 string      `json:"host"`
+		AppToken             string      `json:"appToken"`
+		SecretKey            string      `json:"secretKey"`
+		WebhookSecret        string      `json:"webhookSecret"`
+		AllowUnprefixedToken bool        `json:"allowUnprefixedToken"`
+	}
+)
+
+// ProfileFromEnv reads the profile from environment variables, DefaultEnvPrefix is used if prefix is empty,
+// e.g. SUMSUB_ENV, SUMSUB_HOST, SUMSUB_APP_TOKEN, SUMSUB_SECRET_KEY, SUMSUB_WEBHOOK_SECRET
+// and SUMSUB_ALLOW_UNPREFIXED_TOKEN. The profile is validated.
+func ProfileFromEnv(prefix string) (Profile, error) {
+	if prefix == "" {
+		prefix = DefaultEnvPrefix
+	}
+	var allowUnprefixed bool
+	if v := os.Getenv(prefix + EnvSuffixAllowUnprefixedToken); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return Profile{}, fmt.Errorf("%s: %w", prefix+EnvSuffixAllowUnprefixedToken, err)
+		}
+		allowUnprefixed = b
+	}
+	p := Profile{
+		Environment:   Environment(os.Getenv(prefix + EnvSuffixEnvironment)),
+		Host:          os.Getenv(prefix + EnvSuffixHost),
+		AppToken:      os.Getenv(prefix + EnvSuffixAppToken),
+		SecretKey:     os.Getenv(prefix + EnvSuffixSecretKey),
+		WebhookSecret: os.Getenv(prefix + EnvSuffixWebhookSecret),
+
+		AllowUnprefixedToken: allowUnprefixed,
+	}
+	return p.normalize()
+}
+
+// LoadProfiles reads JSON object of named profiles:
+//
+//	{"eu-sandbox": {"environment": "sandbox", "host": "", "appToken": "sbx:...", "secretKey": "...", "webhookSecret": "..."}}
+//
+// String values consisting entirely of the ${VAR} reference are replaced with the environment variable
+// so the secrets could be kept out of the file, other values are taken as is, "$" in secrets is kept.
+// All profiles are validated.
+func LoadProfiles(r io.Reader) (Profiles, error) {
+	var raw map[string]jsonProfile
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("decode: %w", err)
+	}
+	res := make(Profiles, len(raw))
+	for name, jp := range raw {
+		p, err := Profile{
+			Environment:   Environment(expandEnvRef(string(jp.Environment))),
+			Host:          expandEnvRef(jp.Host),
+			AppToken:      expandEnvRef(jp.AppToken),
+			SecretKey:     expandEnvRef(jp.SecretKey),
+			WebhookSecret: expandEnvRef(jp.WebhookSecret),
+
+			AllowUnprefixedToken: jp.AllowUnprefixedToken,
+		}.normalize()
+		if err != nil {
+			return nil, fmt.Errorf("profile %s: %w", name, err)
+		}
+		res[name] = p
+	}
+	return res, nil
+}
+
+// LoadProfilesFile reads profiles from the file, see LoadProfiles.
+func LoadProfilesFile(path string) (Profiles, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open: %w", err)
+	}
+	defer f.Close() //nolint: errcheck
+	return LoadProfiles(f)
+}
+
+func (ps Profiles) Get(name string) (Profile, error) {
+	p, ok := ps[name]
+	if !ok {
+		return Profile{}, fmt.Errorf("unknown profile: %s", name)
+	}
+	return p, nil
+}
+
+// Validate checks the required credentials and that the app token belongs to the environment.
+// Tokens without the environment prefix are rejected unless AllowUnprefixedToken is set.
+func (p Profile) Validate() error {
+	switch p.Environment {
+	case EnvironmentSandbox, EnvironmentProduction:
+	case "":
+		return errors.New("empty environment")
+	default:
+		return fmt.Errorf("unknown environment: %s", p.Environment)
+	}
+	if p.AppToken == "" {
+		return errors.New("empty app token")
+	}
+	if p.SecretKey == "" {
+		return errors.New("empty secret key")
+	}
+	env, ok := tokenEnvironment(p.AppToken)
+	switch {
+	case !ok && !p.AllowUnprefixedToken:
+		return fmt.Errorf("%w: token without %s or %s prefix", ErrTokenEnvironmentMismatch, tokenPrefixSandbox, tokenPrefixProduction)
+	case ok && env != p.Environment:
+		return fmt.Errorf("%w: %s token for %s", ErrTokenEnvironmentMismatch, env, p.Environment)
+	}
+	return nil
+}
+
+// NewClient validates the profile and builds the client, sandbox profiles get WithSandbox.
+// The environment is inferred from the app token if empty. opts are applied after the profile ones.
+func (p Profile) NewClient(opts ...Opt) (*Client, error) {
+	p, err := p.normalize()
+	if err != nil {
+		return nil, err
+	}
+	base := []Opt{WithHost(p.host())}
+	if p.Environment == EnvironmentSandbox {
+		base = append(base, WithSandbox())
+	}
+	return NewClient(p.AppToken, NewHMACSigner(p.SecretKey), append(base, opts...)...), nil
+}
+
+// WebhookVerifier returns the verifier of the webhooks signed with the profile webhook secret.
+func (p Profile) WebhookVerifier() (*WebhookVerifier, error) {
+	if p.WebhookSecret == "" {
+		return nil, errors.New("empty webhook secret")
+	}
+	return NewWebhookVerifier(p.WebhookSecret), nil
+}
+
+func (p Profile) host() string {
+	if p.Host == "" {
+		return Host
+	}
+	return p.Host
+}
+
+// normalize infers the environment from the app token and validates the profile.
+func (p Profile) normalize() (Profile, error) {
+	if p.Environment == "" {
+		if env, ok := tokenEnvironment(p.AppToken); ok {
+			p.Environment = env
+		}
+	}
+	if err := p.Validate(); err != nil {
+		return Profile{}, err
+	}
+	return p, nil
+}
+
+// expandEnvRef value of the environment variable if s is exactly ${VAR}, s otherwise.
+func expandEnvRef(s string) string {
+	if !strings.HasPrefix(s, "${") || !strings.HasSuffix(s, "}") {
+		return s
+	}
+	name := s[2 : len(s)-1]
+	if name == "" || strings.ContainsAny(name, "${}") {
+		return s
+	}
+	return os.Getenv(name)
+}
+
+// tokenEnvironment environment by the app token prefix, false for tokens without known prefix.
+func tokenEnvironment(token string) (Environment, bool) {
+	switch {
+	case strings.HasPrefix(token, tokenPrefixSandbox):
+		return EnvironmentSandbox, true
+	case strings.HasPrefix(token, tokenPrefixProduction):
+		return EnvironmentProduction, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,107 @@
+package sumsub
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileFromEnv(t *testing.T) {
+	t.Setenv("SUMSUB_APP_TOKEN", "sbx:token")
+	t.Setenv("SUMSUB_SECRET_KEY", "secret")
+	t.Setenv("SUMSUB_WEBHOOK_SECRET", "webhook")
+
+	p, err := ProfileFromEnv("")
+	require.NoError(t, err)
+	assert.Equal(t, Profile{
+		Environment:   EnvironmentSandbox,
+		AppToken:      "sbx:token",
+		SecretKey:     "secret",
+		WebhookSecret: "webhook",
+	}, p)
+
+	t.Setenv("SUMSUB_ENV", "production")
+	_, err = ProfileFromEnv("")
+	assert.True(t, errors.Is(err, ErrTokenEnvironmentMismatch))
+	assert.EqualError(t, err, "app token does not match environment: sandbox token for production")
+
+	t.Setenv("EU_APP_TOKEN", "legacy-token")
+	t.Setenv("EU_SECRET_KEY", "secret")
+	_, err = ProfileFromEnv("EU")
+	assert.EqualError(t, err, "empty environment")
+	t.Setenv("EU_ENV", "production")
+	_, err = ProfileFromEnv("EU")
+	assert.True(t, errors.Is(err, ErrTokenEnvironmentMismatch))
+	assert.EqualError(t, err, "app token does not match environment: token without sbx: or prd: prefix")
+	t.Setenv("EU_ALLOW_UNPREFIXED_TOKEN", "true")
+	p, err = ProfileFromEnv("EU")
+	require.NoError(t, err)
+	assert.Equal(t, EnvironmentProduction, p.Environment)
+	assert.True(t, p.AllowUnprefixedToken)
+	t.Setenv("EU_ALLOW_UNPREFIXED_TOKEN", "maybe")
+	_, err = ProfileFromEnv("EU")
+	assert.Error(t, err)
+}
+
+func TestLoadProfiles(t *testing.T) {
+	t.Setenv("TEST_PRD_SECRET", "prd-secret")
+	path := filepath.Join(t.TempDir(), "profiles.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+		"sandbox": {"appToken": "sbx:token", "secretKey": "sbx-secret", "webhookSecret": "sbx-webhook"},
+		"production": {"environment": "production", "host": "api.example.com", "appToken": "prd:token", "secretKey": "${TEST_PRD_SECRET}"}
+	}`), 0o600))
+
+	ps, err := LoadProfilesFile(path)
+	require.NoError(t, err)
+
+	sbx, err := ps.Get("sandbox")
+	require.NoError(t, err)
+	assert.Equal(t, EnvironmentSandbox, sbx.Environment)
+	cli, err := sbx.NewClient()
+	require.NoError(t, err)
+	assert.True(t, cli.sandbox)
+	assert.Equal(t, Host, cli.host)
+	_, err = sbx.WebhookVerifier()
+	assert.NoError(t, err)
+
+	prd, err := ps.Get("production")
+	require.NoError(t, err)
+	assert.Equal(t, "prd-secret", prd.SecretKey)
+	cli, err = prd.NewClient()
+	require.NoError(t, err)
+	assert.False(t, cli.sandbox)
+	assert.Equal(t, "api.example.com", cli.host)
+	_, err = prd.WebhookVerifier()
+	assert.EqualError(t, err, "empty webhook secret")
+
+	_, err = ps.Get("staging")
+	assert.EqualError(t, err, "unknown profile: staging")
+
+	_, err = LoadProfiles(strings.NewReader(`{"sandbox": {"environment": "sandbox", "appToken": "prd:token", "secretKey": "secret"}}`))
+	assert.True(t, errors.Is(err, ErrTokenEnvironmentMismatch))
+
+	// only whole ${VAR} values are expanded, literal secrets keep "$"
+	ps, err = LoadProfiles(strings.NewReader(`{"p": {"appToken": "prd:token", "secretKey": "s3$cr${TEST_PRD_SECRET}", "webhookSecret": "$TEST_PRD_SECRET"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, "s3$cr${TEST_PRD_SECRET}", ps["p"].SecretKey)
+	assert.Equal(t, "$TEST_PRD_SECRET", ps["p"].WebhookSecret)
+
+	_, err = LoadProfiles(strings.NewReader(`{"legacy": {"environment": "production", "appToken": "legacy-token", "secretKey": "secret"}}`))
+	assert.True(t, errors.Is(err, ErrTokenEnvironmentMismatch))
+	ps, err = LoadProfiles(strings.NewReader(`{"legacy": {"environment": "production", "appToken": "legacy-token", "secretKey": "secret", "allowUnprefixedToken": true}}`))
+	require.NoError(t, err)
+	assert.True(t, ps["legacy"].AllowUnprefixedToken)
+
+	_, err = Profile{Environment: EnvironmentSandbox, AppToken: "prd:token", SecretKey: "secret"}.NewClient()
+	assert.True(t, errors.Is(err, ErrTokenEnvironmentMismatch))
+
+	// environment is inferred from the token prefix
+	cli, err = Profile{AppToken: "sbx:token", SecretKey: "secret"}.NewClient()
+	require.NoError(t, err)
+	assert.True(t, cli.sandbox)
+}
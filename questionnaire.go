@@ -0,0 +1,362 @@
+package sumsub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// questionnaireTag struct tag mapping a field to the questionnaire item: `sumsub:"section.item"` or
+// `sumsub:"section.item,omitempty"`.
+const questionnaireTag = "sumsub"
+
+type (
+	// Questionnaire answers of the applicant to the level questionnaire.
+	// Items keep values as strings the way SumSub stores them, use typed accessors or Decode.
+	Questionnaire struct {
+		ID       string
+		Sections map[string]QuestionnaireSection
+	}
+
+	QuestionnaireSection struct {
+		Score float64
+		Items map[string]QuestionnaireItem
+	}
+
+	// QuestionnaireItem answer to the question: Value for single answer questions, Values for multi select.
+	QuestionnaireItem struct {
+		Value  string
+		Values []string
+	}
+
+	SubmitQuestionnaireRequest struct {
+		ApplicantID   string
+		Questionnaire Questionnaire
+	}
+)
+
+type (
+	reqQuestionnaire struct {
+		ID       string                             `json:"id"`
+		Sections map[string]reqQuestionnaireSection `json:"sections"`
+	}
+
+	reqQuestionnaireSection struct {
+		Score float64                         `json:"score,omitempty"`
+		Items map[string]reqQuestionnaireItem `json:"items"`
+	}
+
+	reqQuestionnaireItem struct {
+		Value  string   `json:"value,omitempty"`
+		Values []string `json:"values,omitempty"`
+	}
+
+	respQuestionnaire struct {
+		ID       string                              `json:"id"`
+		Sections map[string]respQuestionnaireSection `json:"sections"`
+	}
+
+	respQuestionnaireSection struct {
+		Score float64                          `json:"score,omitempty"`
+		Items map[string]respQuestionnaireItem `json:"items"`
+	}
+
+	respQuestionnaireItem struct {
+		Value  string   `json:"value,omitempty"`
+		Values []string `json:"values,omitempty"`
+	}
+)
+
+// SubmitQuestionnaire Use this method to fill the level questionnaire of the applicant from the server side.
+// https://docs.sumsub.com/reference/add-questionnaire
+func (c *Client) SubmitQuestionnaire(ctx context.Context, req SubmitQuestionnaireRequest) error {
+	if req.Questionnaire.ID == "" {
+		return errors.New("questionnaire id required")
+	}
+	_, err := call[reqQuestionnaire, json.RawMessage](ctx, c,
+		http.MethodPost,
+		fmt.Sprintf("/resources/applicants/%s/questionnaires", url.PathEscape(req.ApplicantID)),
+		newReqQuestionnaire(req.Questionnaire),
+	)
+	if err != nil {
+		return fmt.Errorf("call: %w", err)
+	}
+	return nil
+}
+
+// Questionnaire returns the questionnaire by id.
+func (r ApplicantDataResponse) Questionnaire(id string) (Questionnaire, bool) {
+	for _, q := range r.Questionnaires {
+		if q.ID == id {
+			return q, true
+		}
+	}
+	return Questionnaire{}, false
+}
+
+// Item returns the answer to the question.
+func (q Questionnaire) Item(section, item string) (QuestionnaireItem, bool) {
+	v, ok := q.Sections[section].Items[item]
+	return v, ok
+}
+
+// Set stores the answer to the question creating the section if needed.
+func (q *Questionnaire) Set(section, item string, v QuestionnaireItem) {
+	if q.Sections == nil {
+		q.Sections = make(map[string]QuestionnaireSection)
+	}
+	s := q.Sections[section]
+	if s.Items == nil {
+		s.Items = make(map[string]QuestionnaireItem)
+	}
+	s.Items[item] = v
+	q.Sections[section] = s
+}
+
+// TextItem single answer item.
+func TextItem(v string) QuestionnaireItem {
+	return QuestionnaireItem{Value: v}
+}
+
+// BoolItem yes/no answer item.
+func BoolItem(v bool) QuestionnaireItem {
+	return QuestionnaireItem{Value: strconv.FormatBool(v)}
+}
+
+// NumberItem numeric answer item.
+func NumberItem(v float64) QuestionnaireItem {
+	return QuestionnaireItem{Value: strconv.FormatFloat(v, 'f', -1, 64)}
+}
+
+// DateItem date answer item.
+func DateItem(v time.Time) QuestionnaireItem {
+	return QuestionnaireItem{Value: v.Format(timeLayoutDate)}
+}
+
+// MultiItem multi select answer item.
+func MultiItem(v ...string) QuestionnaireItem {
+	return QuestionnaireItem{Values: v}
+}
+
+// Bool parses the yes/no answer.
+func (i QuestionnaireItem) Bool() (bool, error) {
+	return strconv.ParseBool(i.Value)
+}
+
+// Int parses the integer answer.
+func (i QuestionnaireItem) Int() (int64, error) {
+	return strconv.ParseInt(i.Value, 10, 64)
+}
+
+// Float parses the numeric answer.
+func (i QuestionnaireItem) Float() (float64, error) {
+	return strconv.ParseFloat(i.Value, 64)
+}
+
+// Date parses the date answer.
+func (i QuestionnaireItem) Date() (time.Time, error) {
+	t, err := ParseTime(i.Value)
+	return t.Time, err
+}
+
+// Decode maps the answers to the struct fields tagged with `sumsub:"section.item"`.
+// Supported field types: string, bool, integers, floats, []string, time.Time and pointers to them.
+// Missing items are skipped, pointer fields stay nil.
+func (q Questionnaire) Decode(v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("decode: pointer to struct expected")
+	}
+	return walkQuestionnaireFields(rv.Elem(), func(section, item string, _ bool, f reflect.Value) error {
+		it, ok := q.Item(section, item)
+		if !ok {
+			return nil
+		}
+		if f.Kind() == reflect.Pointer {
+			p := reflect.New(f.Type().Elem())
+			if err := decodeQuestionnaireItem(it, p.Elem()); err != nil {
+				return fmt.Errorf("decode: %s.%s: %w", section, item, err)
+			}
+			f.Set(p)
+			return nil
+		}
+		if err := decodeQuestionnaireItem(it, f); err != nil {
+			return fmt.Errorf("decode: %s.%s: %w", section, item, err)
+		}
+		return nil
+	})
+}
+
+// EncodeQuestionnaire builds the questionnaire from the struct fields tagged with `sumsub:"section.item"`.
+// Bools and numbers are always encoded, so false and 0 are submitted as answers. Nil pointers, empty strings,
+// empty slices and zero time.Time are skipped as unanswered, as well as any zero value with the omitempty option.
+func EncodeQuestionnaire(id string, v any) (Questionnaire, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return Questionnaire{}, errors.New("encode: struct expected")
+	}
+	q := Questionnaire{ID: id}
+	err := walkQuestionnaireFields(rv, func(section, item string, omitEmpty bool, f reflect.Value) error {
+		if f.Kind() == reflect.Pointer {
+			if f.IsNil() {
+				return nil
+			}
+			f = f.Elem()
+		}
+		if f.Kind() == reflect.Slice && f.Len() == 0 {
+			return nil
+		}
+		if f.IsZero() && (omitEmpty || !questionnaireAlwaysEncoded(f)) {
+			return nil
+		}
+		it, err := encodeQuestionnaireItem(f)
+		if err != nil {
+			return fmt.Errorf("encode: %s.%s: %w", section, item, err)
+		}
+		q.Set(section, item, it)
+		return nil
+	})
+	if err != nil {
+		return Questionnaire{}, err
+	}
+	return q, nil
+}
+
+func walkQuestionnaireFields(rv reflect.Value, fn func(section, item string, omitEmpty bool, f reflect.Value) error) error {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		tag, ok := rt.Field(i).Tag.Lookup(questionnaireTag)
+		if !ok || tag == "-" || !rt.Field(i).IsExported() {
+			continue
+		}
+		name, opt, _ := strings.Cut(tag, ",")
+		section, item, ok := strings.Cut(name, ".")
+		if !ok || section == "" || item == "" || (opt != "" && opt != "omitempty") {
+			return fmt.Errorf("field %s: invalid tag: %q", rt.Field(i).Name, tag)
+		}
+		if err := fn(section, item, opt == "omitempty", rv.Field(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// questionnaireAlwaysEncoded reports whether the zero value of the field is a meaningful answer: false or 0.
+func questionnaireAlwaysEncoded(f reflect.Value) bool {
+	switch f.Kind() {
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func decodeQuestionnaireItem(it QuestionnaireItem, f reflect.Value) error {
+	if f.Type() == timeType {
+		t, err := it.Date()
+		if err != nil {
+			return err
+		}
+		f.Set(reflect.ValueOf(t))
+		return nil
+	}
+	switch f.Kind() {
+	case reflect.String:
+		f.SetString(it.Value)
+	case reflect.Bool:
+		b, err := it.Bool()
+		if err != nil {
+			return err
+		}
+		f.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(it.Value, 10, f.Type().Bits())
+		if err != nil {
+			return err
+		}
+		f.SetInt(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(it.Value, f.Type().Bits())
+		if err != nil {
+			return err
+		}
+		f.SetFloat(n)
+	case reflect.Slice:
+		if f.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported type: %s", f.Type())
+		}
+		values := reflect.MakeSlice(f.Type(), len(it.Values), len(it.Values))
+		for i, v := range it.Values {
+			values.Index(i).SetString(v)
+		}
+		f.Set(values)
+	default:
+		return fmt.Errorf("unsupported type: %s", f.Type())
+	}
+	return nil
+}
+
+func encodeQuestionnaireItem(f reflect.Value) (QuestionnaireItem, error) {
+	if f.Type() == timeType {
+		return DateItem(f.Interface().(time.Time)), nil //nolint: errcheck
+	}
+	switch f.Kind() {
+	case reflect.String:
+		return TextItem(f.String()), nil
+	case reflect.Bool:
+		return BoolItem(f.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return TextItem(strconv.FormatInt(f.Int(), 10)), nil
+	case reflect.Float32, reflect.Float64:
+		return NumberItem(f.Float()), nil
+	case reflect.Slice:
+		if f.Type().Elem().Kind() != reflect.String {
+			return QuestionnaireItem{}, fmt.Errorf("unsupported type: %s", f.Type())
+		}
+		values := make([]string, f.Len())
+		for i := range values {
+			values[i] = f.Index(i).String()
+		}
+		return MultiItem(values...), nil
+	default:
+		return QuestionnaireItem{}, fmt.Errorf("unsupported type: %s", f.Type())
+	}
+}
+
+func (r respQuestionnaire) model() Questionnaire {
+	q := Questionnaire{ID: r.ID}
+	for sid, s := range r.Sections {
+		if q.Sections == nil {
+			q.Sections = make(map[string]QuestionnaireSection, len(r.Sections))
+		}
+		section := QuestionnaireSection{Score: s.Score, Items: make(map[string]QuestionnaireItem, len(s.Items))}
+		for iid, it := range s.Items {
+			section.Items[iid] = QuestionnaireItem(it)
+		}
+		q.Sections[sid] = section
+	}
+	return q
+}
+
+func newReqQuestionnaire(q Questionnaire) reqQuestionnaire {
+	r := reqQuestionnaire{ID: q.ID, Sections: make(map[string]reqQuestionnaireSection, len(q.Sections))}
+	for sid, s := range q.Sections {
+		section := reqQuestionnaireSection{Score: s.Score, Items: make(map[string]reqQuestionnaireItem, len(s.Items))}
+		for iid, it := range s.Items {
+			section.Items[iid] = reqQuestionnaireItem(it)
+		}
+		r.Sections[sid] = section
+	}
+	return r
+}
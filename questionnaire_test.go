@@ -0,0 +1,144 @@
+package sumsub
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testQuestionnaire struct {
+	Occupation  string    `sumsub:"profile.occupation"`
+	Income      float64   `sumsub:"profile.income"`
+	Dependents  int       `sumsub:"profile.dependents"`
+	PEP         bool      `sumsub:"compliance.pep"`
+	Sources     []string  `sumsub:"compliance.sources"`
+	EmployedAt  time.Time `sumsub:"profile.employedAt"`
+	NotMapped   string
+	notExported string `sumsub:"profile.hidden"` //nolint: unused
+}
+
+func TestQuestionnaireEncodeDecode(t *testing.T) {
+	in := testQuestionnaire{
+		Occupation: "developer",
+		Income:     1234.5,
+		Dependents: 2,
+		PEP:        true,
+		Sources:    []string{"salary", "savings"},
+		EmployedAt: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC),
+		NotMapped:  "skip",
+	}
+	q, err := EncodeQuestionnaire("kyc", in)
+	require.NoError(t, err)
+	assert.Equal(t, Questionnaire{
+		ID: "kyc",
+		Sections: map[string]QuestionnaireSection{
+			"profile": {Items: map[string]QuestionnaireItem{
+				"occupation": {Value: "developer"},
+				"income":     {Value: "1234.5"},
+				"dependents": {Value: "2"},
+				"employedAt": {Value: "2020-01-02"},
+			}},
+			"compliance": {Items: map[string]QuestionnaireItem{
+				"pep":     {Value: "true"},
+				"sources": {Values: []string{"salary", "savings"}},
+			}},
+		},
+	}, q)
+
+	var out testQuestionnaire
+	require.NoError(t, q.Decode(&out))
+	in.NotMapped = ""
+	assert.Equal(t, in, out)
+
+	q.Set("profile", "dependents", TextItem("two"))
+	assert.EqualError(t, q.Decode(&out), `decode: profile.dependents: strconv.ParseInt: parsing "two": invalid syntax`)
+	assert.EqualError(t, q.Decode(out), "decode: pointer to struct expected")
+
+	_, err = EncodeQuestionnaire("kyc", struct {
+		V string `sumsub:"invalid"`
+	}{V: "v"})
+	assert.EqualError(t, err, `field V: invalid tag: "invalid"`)
+}
+
+func TestEncodeQuestionnaireZeroAnswers(t *testing.T) {
+	type answers struct {
+		PEP        bool     `sumsub:"compliance.pep"`
+		Dependents int      `sumsub:"profile.dependents"`
+		Income     float64  `sumsub:"profile.income,omitempty"`
+		Employed   *bool    `sumsub:"profile.employed"`
+		Children   *int     `sumsub:"profile.children"`
+		Comment    string   `sumsub:"profile.comment"`
+		Sources    []string `sumsub:"compliance.sources"`
+	}
+	zero := 0
+	q, err := EncodeQuestionnaire("kyc", answers{Children: &zero})
+	require.NoError(t, err)
+	assert.Equal(t, Questionnaire{
+		ID: "kyc",
+		Sections: map[string]QuestionnaireSection{
+			"profile": {Items: map[string]QuestionnaireItem{
+				"dependents": {Value: "0"},
+				"children":   {Value: "0"},
+			}},
+			"compliance": {Items: map[string]QuestionnaireItem{
+				"pep": {Value: "false"},
+			}},
+		},
+	}, q)
+
+	var out answers
+	require.NoError(t, q.Decode(&out))
+	assert.Nil(t, out.Employed)
+	require.NotNil(t, out.Children)
+	assert.Equal(t, 0, *out.Children)
+
+	_, err = EncodeQuestionnaire("kyc", struct {
+		V string `sumsub:"profile.v,omitnil"`
+	}{V: "v"})
+	assert.EqualError(t, err, `field V: invalid tag: "profile.v,omitnil"`)
+}
+
+func TestSubmitQuestionnaire(t *testing.T) {
+	cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/resources/applicants/5b594ade0a975a36c9349e66/questionnaires", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"id":"kyc","sections":{"profile":{"items":{"occupation":{"value":"developer"},"hobbies":{"values":["chess"]}}}}}`, string(body))
+		_, _ = w.Write([]byte(`{"id":"5b594ade0a975a36c9349e66"}`))
+	})
+
+	q := Questionnaire{ID: "kyc"}
+	q.Set("profile", "occupation", TextItem("developer"))
+	q.Set("profile", "hobbies", MultiItem("chess"))
+	require.NoError(t, cli.SubmitQuestionnaire(context.Background(), SubmitQuestionnaireRequest{
+		ApplicantID:   "5b594ade0a975a36c9349e66",
+		Questionnaire: q,
+	}))
+
+	assert.EqualError(t, cli.SubmitQuestionnaire(context.Background(), SubmitQuestionnaireRequest{}), "questionnaire id required")
+}
+
+func TestApplicantDataQuestionnaires(t *testing.T) {
+	var resp respApplicantData
+	require.NoError(t, json.Unmarshal([]byte(`{
+  "id": "5b594ade0a975a36c9349e66",
+  "questionnaires": [
+    {"id": "kyc", "sections": {"profile": {"score": 5, "items": {"occupation": {"value": "developer"}}}}}
+  ]
+}`), &resp))
+
+	q, ok := resp.model().Questionnaire("kyc")
+	require.True(t, ok)
+	assert.Equal(t, 5.0, q.Sections["profile"].Score)
+	it, ok := q.Item("profile", "occupation")
+	assert.True(t, ok)
+	assert.Equal(t, "developer", it.Value)
+	_, ok = q.Item("unknown", "occupation")
+	assert.False(t, ok)
+}
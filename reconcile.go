@@ -0,0 +1,243 @@
+package sumsub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+type (
+	// ReviewState review state as known by the caller.
+	ReviewState struct {
+		ReviewStatus     ReviewStatus
+		ReviewAnswer     ReviewAnswer
+		ReviewRejectType ReviewRejectType
+		RejectLabels     []RejectLabel
+	}
+
+	// ReconcileItem applicant to reconcile, ApplicantID or ExternalUserID is required.
+	ReconcileItem struct {
+		ApplicantID    string
+		ExternalUserID string
+		Known          ReviewState
+	}
+
+	ReconcileChange struct {
+		ApplicantID    string
+		ExternalUserID string
+		InspectionID   string
+		ClientID       string
+		LevelName      string
+		Old            ReviewState
+		New            ReviewState
+		Status         ApplicantReviewStatusResponse
+	}
+
+	ReconcileError struct {
+		Item ReconcileItem
+		Err  error
+	}
+
+	ReconcileResult struct {
+		Changes   []ReconcileChange // in the order of the items
+		Errors    []ReconcileError
+		Unchanged int
+		// Unfetched items not reconciled because of the context cancellation, in the order of the items
+		Unfetched []ReconcileItem
+	}
+
+	// Reconciler fetches the actual review status of applicants and diffs it with the caller state,
+	// e.g. to recover after the lost webhooks.
+	Reconciler struct {
+		cli         *Client
+		parallelism int
+	}
+
+	reconcilerOptions struct {
+		Parallelism int
+	}
+
+	ReconcilerOpt func(*reconcilerOptions)
+)
+
+func NewReconciler(cli *Client, opts ...ReconcilerOpt) *Reconciler {
+	o := reconcilerOptions{
+		Parallelism: 4,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.Parallelism <= 0 {
+		o.Parallelism = 1
+	}
+	return &Reconciler{
+		cli:         cli,
+		parallelism: o.Parallelism,
+	}
+}
+
+// WithReconcileParallelism max number of applicants fetched in parallel.
+func WithReconcileParallelism(n int) ReconcilerOpt {
+	return func(opts *reconcilerOptions) {
+		opts.Parallelism = n
+	}
+}
+
+// Reconcile fetches the review status of each item and returns the changes against the known state.
+// Items failed to fetch are reported in ReconcileResult.Errors, only context errors abort the run:
+// the partial result is returned with the context error, items not reconciled are in ReconcileResult.Unfetched.
+func (r *Reconciler) Reconcile(ctx context.Context, items []ReconcileItem) (ReconcileResult, error) {
+	type outcome struct {
+		change  *ReconcileChange
+		err     error
+		skipped bool
+	}
+	outcomes := make([]outcome, len(items))
+
+	sem := make(chan struct{}, r.parallelism)
+	var wg sync.WaitGroup
+	for i := range items {
+		if ctx.Err() != nil {
+			outcomes[i].skipped = true
+			continue
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			outcomes[i].skipped = true
+			continue
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			outcomes[i].change, outcomes[i].err = r.reconcile(ctx, items[i])
+		}(i)
+	}
+	wg.Wait()
+	ctxErr := ctx.Err()
+
+	var res ReconcileResult
+	for i, o := range outcomes {
+		switch {
+		case o.skipped, o.err != nil && ctxErr != nil && errors.Is(o.err, ctxErr):
+			res.Unfetched = append(res.Unfetched, items[i])
+		case o.err != nil:
+			res.Errors = append(res.Errors, ReconcileError{Item: items[i], Err: o.err})
+		case o.change != nil:
+			res.Changes = append(res.Changes, *o.change)
+		default:
+			res.Unchanged++
+		}
+	}
+	return res, ctxErr
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, item ReconcileItem) (*ReconcileChange, error) {
+	applicantID := item.ApplicantID
+	var data *ApplicantDataResponse
+	if applicantID == "" {
+		if item.ExternalUserID == "" {
+			return nil, fmt.Errorf("applicant id or external user id required")
+		}
+		resp, err := r.cli.ApplicantData(ctx, ApplicantDataRequest{ExternalUserID: item.ExternalUserID})
+		if err != nil {
+			return nil, fmt.Errorf("resolve applicant: %w", err)
+		}
+		data, applicantID = &resp, resp.ID
+	}
+
+	status, err := r.cli.ApplicantReviewStatus(ctx, ApplicantReviewStatusRequest{ApplicantID: applicantID})
+	if err != nil {
+		return nil, fmt.Errorf("review status: %w", err)
+	}
+	actual := reviewStateOf(status)
+	if actual.Equal(item.Known) {
+		return nil, nil
+	}
+	if data == nil {
+		// applicant details are needed to fill the webhook, fetched for the changed items only
+		resp, err := r.cli.ApplicantData(ctx, ApplicantDataRequest{ApplicantID: applicantID})
+		if err != nil {
+			return nil, fmt.Errorf("applicant data: %w", err)
+		}
+		data = &resp
+	}
+	return &ReconcileChange{
+		ApplicantID:    applicantID,
+		ExternalUserID: data.ExternalUserID,
+		InspectionID:   data.InspectionID,
+		ClientID:       data.ClientID,
+		LevelName:      data.Review.LevelName,
+		Old:            item.Known,
+		New:            actual,
+		Status:         status,
+	}, nil
+}
+
+// Equal compares the states, reject labels order does not matter.
+func (s ReviewState) Equal(o ReviewState) bool {
+	if s.ReviewStatus != o.ReviewStatus || s.ReviewAnswer != o.ReviewAnswer || s.ReviewRejectType != o.ReviewRejectType {
+		return false
+	}
+	if len(s.RejectLabels) != len(o.RejectLabels) {
+		return false
+	}
+	counts := make(map[RejectLabel]int, len(s.RejectLabels))
+	for _, l := range s.RejectLabels {
+		counts[l]++
+	}
+	for _, l := range o.RejectLabels {
+		if counts[l] == 0 {
+			return false
+		}
+		counts[l]--
+	}
+	return true
+}
+
+// Webhook synthesizes the webhook event as if it was sent by SumSub for the new state,
+// so the change can be applied by the same code that handles webhooks.
+// CorrelationID is left empty and SandboxMode false, the API does not return them with the applicant.
+func (c ReconcileChange) Webhook(now time.Time) Webhook {
+	return Webhook{
+		ApplicantID:    c.ApplicantID,
+		InspectionID:   c.InspectionID,
+		ExternalUserID: c.ExternalUserID,
+		LevelName:      c.LevelName,
+		ClientID:       c.ClientID,
+		Type:           webhookTypeForStatus(c.New.ReviewStatus),
+		ReviewStatus:   c.Status.ReviewStatus,
+		ReviewResult:   c.Status.ReviewResult,
+		CreatedAt:      now.UTC(),
+	}
+}
+
+func reviewStateOf(status ApplicantReviewStatusResponse) ReviewState {
+	return ReviewState{
+		ReviewStatus:     status.ReviewStatus,
+		ReviewAnswer:     status.ReviewResult.ReviewAnswer,
+		ReviewRejectType: status.ReviewResult.ReviewRejectType,
+		RejectLabels:     status.ReviewResult.RejectLabels,
+	}
+}
+
+// webhookTypeForStatus webhook type SumSub sends when the applicant moves to the status.
+func webhookTypeForStatus(status ReviewStatus) string {
+	switch status {
+	case ReviewStatusInit:
+		return WebhookTypeApplicantCreated
+	case ReviewStatusPrechecked:
+		return WebhookTypeApplicantPrechecked
+	case ReviewStatusCompleted:
+		return WebhookTypeApplicantReviewed
+	case ReviewStatusOnHold:
+		return WebhookTypeApplicantOnHold
+	default:
+		return WebhookTypeApplicantPending
+	}
+}
@@ -0,0 +1,130 @@
+package sumsub
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconciler(t *testing.T) {
+	var inFlight, peak int32
+	cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		switch r.URL.Path {
+		case "/resources/applicants/-;externalUserId=user-1/one":
+			_, _ = w.Write([]byte(`{"id":"app-1","externalUserId":"user-1","inspectionId":"insp-1","clientId":"client","review":{"levelName":"basic-kyc"}}`))
+		case "/resources/applicants/app-3/one":
+			_, _ = w.Write([]byte(`{"id":"app-3","externalUserId":"user-3"}`))
+		case "/resources/applicants/app-1/status":
+			_, _ = w.Write([]byte(`{"reviewStatus":"completed","reviewResult":{"reviewAnswer":"RED","rejectLabels":["FORGERY","SELFIE_MISMATCH"],"reviewRejectType":"FINAL"}}`))
+		case "/resources/applicants/app-2/status", "/resources/applicants/app-3/status":
+			_, _ = w.Write([]byte(`{"reviewStatus":"pending"}`))
+		case "/resources/applicants/app-4/status":
+			writeAPIError(w, http.StatusNotFound, 0)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	items := []ReconcileItem{
+		{ExternalUserID: "user-1", Known: ReviewState{ReviewStatus: ReviewStatusPending}},
+		{ApplicantID: "app-2", Known: ReviewState{ReviewStatus: ReviewStatusPending}},
+		{ApplicantID: "app-3", Known: ReviewState{ReviewStatus: ReviewStatusInit}},
+		{ApplicantID: "app-4"},
+	}
+	res, err := NewReconciler(cli, WithReconcileParallelism(2)).Reconcile(context.Background(), items)
+	require.NoError(t, err)
+	assert.LessOrEqual(t, atomic.LoadInt32(&peak), int32(2))
+
+	assert.Equal(t, 1, res.Unchanged)
+	require.Len(t, res.Errors, 1)
+	assert.Equal(t, "app-4", res.Errors[0].Item.ApplicantID)
+	require.Len(t, res.Changes, 2)
+
+	ch := res.Changes[0]
+	assert.Equal(t, "app-1", ch.ApplicantID)
+	assert.Equal(t, "user-1", ch.ExternalUserID)
+	assert.Equal(t, ReviewState{ReviewStatus: ReviewStatusPending}, ch.Old)
+	assert.Equal(t, ReviewState{
+		ReviewStatus:     ReviewStatusCompleted,
+		ReviewAnswer:     ReviewAnswerRed,
+		ReviewRejectType: ReviewRejectTypeFinal,
+		RejectLabels:     []RejectLabel{RejectLabelForgery, RejectLabelSelfieMismatch},
+	}, ch.New)
+
+	now := time.Date(2023, 2, 6, 7, 20, 54, 0, time.UTC)
+	wh := ch.Webhook(now)
+	assert.Equal(t, WebhookTypeApplicantReviewed, wh.Type)
+	assert.Equal(t, "app-1", wh.ApplicantID)
+	assert.Equal(t, "user-1", wh.ExternalUserID)
+	assert.Equal(t, "insp-1", wh.InspectionID)
+	assert.Equal(t, "client", wh.ClientID)
+	assert.Equal(t, "basic-kyc", wh.LevelName)
+	assert.True(t, wh.ReviewResult.IsFinalRejected())
+	assert.Equal(t, now, wh.CreatedAt)
+
+	assert.Equal(t, "app-3", res.Changes[1].ApplicantID)
+	assert.Equal(t, "user-3", res.Changes[1].ExternalUserID)
+	assert.Equal(t, WebhookTypeApplicantPending, res.Changes[1].Webhook(now).Type)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	res, err = NewReconciler(cli).Reconcile(ctx, items)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, ReconcileResult{Unfetched: items}, res)
+}
+
+func TestReconcilerCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cli := NewClient("token", NewHMACSigner("secret"), WithHTTPClient(&http.Client{
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			if r.URL.Path == "/resources/applicants/app-2/status" {
+				cancel()
+				return nil, r.Context().Err()
+			}
+			assert.Equal(t, "/resources/applicants/app-1/status", r.URL.Path)
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": {"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"reviewStatus":"pending"}`)),
+				Request:    r,
+			}, nil
+		}),
+	}))
+
+	items := []ReconcileItem{
+		{ApplicantID: "app-1", Known: ReviewState{ReviewStatus: ReviewStatusPending}},
+		{ApplicantID: "app-2"},
+		{ApplicantID: "app-3"},
+	}
+	res, err := NewReconciler(cli, WithReconcileParallelism(1)).Reconcile(ctx, items)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, ReconcileResult{Unchanged: 1, Unfetched: items[1:]}, res)
+}
+
+func TestReviewState_Equal(t *testing.T) {
+	a := ReviewState{ReviewStatus: ReviewStatusCompleted, ReviewAnswer: ReviewAnswerRed, RejectLabels: []RejectLabel{RejectLabelForgery, RejectLabelSelfieMismatch}}
+	b := a
+	b.RejectLabels = []RejectLabel{RejectLabelSelfieMismatch, RejectLabelForgery}
+	assert.True(t, a.Equal(b))
+	b.RejectLabels = []RejectLabel{RejectLabelForgery, RejectLabelForgery}
+	assert.False(t, a.Equal(b))
+	assert.False(t, a.Equal(ReviewState{ReviewStatus: ReviewStatusCompleted}))
+}
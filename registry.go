@@ -0,0 +1,274 @@
+package sumsub
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrUnknownTenant tenant is not registered.
+	ErrUnknownTenant = errors.New("unknown tenant")
+	// ErrNoTenantMatched webhook digest does not match webhook secret of any tenant.
+	ErrNoTenantMatched = errors.New("no tenant matched webhook")
+	// ErrAmbiguousTenant several tenants share the webhook secret, route such webhooks by the tenant header.
+	ErrAmbiguousTenant = errors.New("several tenants matched webhook")
+)
+
+type (
+	// Registry holds clients of several SumSub accounts keyed by tenant.
+	// All clients share the same HTTP client (transport and connection pool).
+	// Tenants can be added, rotated and removed while the registry is in use.
+	Registry struct {
+		httpClient *http.Client
+		header     string
+		clientOpts []Opt
+		grace      time.Duration
+		now        NowFunc
+		onError    func(error)
+
+		mu      sync.RWMutex
+		tenants map[string]*registryTenant
+	}
+
+	registryOptions struct {
+		HTTPClient         *http.Client
+		TenantHeader       string
+		ClientOpts         []Opt
+		WebhookSecretGrace time.Duration
+		NowFunc            NowFunc
+		OnWebhookError     func(error)
+	}
+
+	RegistryOpt func(*registryOptions)
+
+	registryTenant struct {
+		client   *Client
+		verifier *WebhookVerifier // nil if the profile has no webhook secret
+		// previous verifier of the rotated webhook secret, accepted until previousUntil
+		previous      *WebhookVerifier
+		previousUntil time.Time
+	}
+)
+
+func NewRegistry(opts ...RegistryOpt) *Registry {
+	o := registryOptions{
+		WebhookSecretGrace: 10 * time.Minute,
+		NowFunc:            time.Now,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.HTTPClient == nil {
+		o.HTTPClient = newDefaultHTTPClient()
+	}
+	return &Registry{
+		httpClient: o.HTTPClient,
+		header:     o.TenantHeader,
+		clientOpts: o.ClientOpts,
+		grace:      o.WebhookSecretGrace,
+		now:        o.NowFunc,
+		onError:    o.OnWebhookError,
+		tenants:    make(map[string]*registryTenant),
+	}
+}
+
+// WithRegistryHTTPClient HTTP client shared by all tenants.
+func WithRegistryHTTPClient(cli *http.Client) RegistryOpt {
+	return func(opts *registryOptions) {
+		opts.HTTPClient = cli
+	}
+}
+
+// WithRegistryTenantHeader routes webhooks by the request header with the tenant name (e.g. set by the proxy
+// or in the webhook URL configuration), requests without the header are matched by the webhook secret.
+func WithRegistryTenantHeader(name string) RegistryOpt {
+	return func(opts *registryOptions) {
+		opts.TenantHeader = name
+	}
+}
+
+// WithRegistryClientOpts options applied to all tenant clients, e.g. WithNowFunc.
+func WithRegistryClientOpts(clientOpts ...Opt) RegistryOpt {
+	return func(opts *registryOptions) {
+		opts.ClientOpts = append(opts.ClientOpts, clientOpts...)
+	}
+}
+
+// WithRegistryWebhookSecretGrace time the previous webhook secret of the tenant is still accepted after Set
+// rotates it, 10 minutes by default, zero disables the overlap.
+func WithRegistryWebhookSecretGrace(d time.Duration) RegistryOpt {
+	return func(opts *registryOptions) {
+		opts.WebhookSecretGrace = d
+	}
+}
+
+// WithRegistryNowFunc clock for the webhook secret grace period.
+func WithRegistryNowFunc(f NowFunc) RegistryOpt {
+	return func(opts *registryOptions) {
+		opts.NowFunc = f
+	}
+}
+
+// WithRegistryWebhookErrorHandler called by WebhookHandler with the reason of the rejected webhook,
+// which is not disclosed in the response.
+func WithRegistryWebhookErrorHandler(h func(error)) RegistryOpt {
+	return func(opts *registryOptions) {
+		opts.OnWebhookError = h
+	}
+}
+
+// Set adds the tenant or replaces its credentials. Clients obtained before keep the old credentials.
+// The replaced webhook secret is accepted for the grace period, see WithRegistryWebhookSecretGrace.
+func (r *Registry) Set(tenant string, p Profile) error {
+	opts := append([]Opt{WithHTTPClient(r.httpClient)}, r.clientOpts...)
+	cli, err := p.NewClient(opts...)
+	if err != nil {
+		return fmt.Errorf("tenant %s: %w", tenant, err)
+	}
+	t := &registryTenant{client: cli}
+	if p.WebhookSecret != "" {
+		t.verifier = NewWebhookVerifier(p.WebhookSecret)
+	}
+
+	r.mu.Lock()
+	if old, ok := r.tenants[tenant]; ok && r.grace > 0 && t.verifier != nil {
+		now := r.now()
+		switch {
+		case old.verifier != nil && old.verifier.secretKey != t.verifier.secretKey:
+			t.previous, t.previousUntil = old.verifier, now.Add(r.grace)
+		case old.previous != nil && now.Before(old.previousUntil):
+			t.previous, t.previousUntil = old.previous, old.previousUntil
+		}
+	}
+	r.tenants[tenant] = t
+	r.mu.Unlock()
+	return nil
+}
+
+// Remove deletes the tenant.
+func (r *Registry) Remove(tenant string) {
+	r.mu.Lock()
+	delete(r.tenants, tenant)
+	r.mu.Unlock()
+}
+
+// Client returns the client of the tenant.
+func (r *Registry) Client(tenant string) (*Client, error) {
+	r.mu.RLock()
+	t, ok := r.tenants[tenant]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTenant, tenant)
+	}
+	return t.client, nil
+}
+
+// Tenants returns sorted tenant names.
+func (r *Registry) Tenants() []string {
+	r.mu.RLock()
+	res := make([]string, 0, len(r.tenants))
+	for name := range r.tenants {
+		res = append(res, name)
+	}
+	r.mu.RUnlock()
+	sort.Strings(res)
+	return res
+}
+
+// VerifyWebhook reads the request body and finds the tenant the webhook is sent to,
+// by the tenant header if configured and present, otherwise by the webhook secret the payload is signed with.
+// ErrAmbiguousTenant is returned if the payload is verified by the secrets of several tenants.
+func (r *Registry) VerifyWebhook(req *http.Request) (string, []byte, error) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return "", nil, fmt.Errorf("read body: %w", err)
+	}
+	algo, digest := req.Header.Get("X-Payload-Digest-Alg"), req.Header.Get("X-Payload-Digest")
+
+	now := r.now()
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.header != "" {
+		if name := req.Header.Get(r.header); name != "" {
+			t, ok := r.tenants[name]
+			if !ok {
+				return "", nil, fmt.Errorf("%w: %s", ErrUnknownTenant, name)
+			}
+			if t.verifier == nil {
+				return "", nil, fmt.Errorf("tenant %s: empty webhook secret", name)
+			}
+			if err = t.verify(body, algo, digest, now); err != nil {
+				return "", nil, fmt.Errorf("tenant %s: %w", name, err)
+			}
+			return name, body, nil
+		}
+	}
+
+	names := make([]string, 0, len(r.tenants))
+	for name := range r.tenants {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var matched []string
+	for _, name := range names {
+		t := r.tenants[name]
+		if t.verifier == nil {
+			continue
+		}
+		if err = t.verify(body, algo, digest, now); err == nil {
+			matched = append(matched, name)
+		}
+	}
+	switch len(matched) {
+	case 0:
+		return "", nil, ErrNoTenantMatched
+	case 1:
+		return matched[0], body, nil
+	default:
+		return "", nil, fmt.Errorf("%w: %s", ErrAmbiguousTenant, strings.Join(matched, ", "))
+	}
+}
+
+// WebhookHandler verifies and parses webhooks and passes them to h with the tenant name.
+// Responds 401 if the webhook can't be verified and 400 if it can't be parsed, the reason is passed
+// to the handler set by WithRegistryWebhookErrorHandler and not written to the response.
+func (r *Registry) WebhookHandler(h func(tenant string, wh Webhook)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		tenant, payload, err := r.VerifyWebhook(req)
+		if err != nil {
+			r.webhookError(fmt.Errorf("verify webhook: %w", err))
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		wh, err := ParseWebhook(payload)
+		if err != nil {
+			r.webhookError(fmt.Errorf("tenant %s: %w", tenant, err))
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+		h(tenant, wh)
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+func (r *Registry) webhookError(err error) {
+	if r.onError != nil {
+		r.onError(err)
+	}
+}
+
+// verify checks the digest with the current webhook secret and with the previous one during the grace period.
+func (t *registryTenant) verify(body []byte, algo, digest string, now time.Time) error {
+	err := t.verifier.Verify(body, algo, digest)
+	if err != nil && t.previous != nil && now.Before(t.previousUntil) && t.previous.Verify(body, algo, digest) == nil {
+		return nil
+	}
+	return err
+}
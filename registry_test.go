@@ -0,0 +1,191 @@
+package sumsub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func signedWebhookRequest(t *testing.T, payload, secret string) *http.Request {
+	t.Helper()
+	digest, err := SignWebhookPayload([]byte(payload), secret, WebhookDigestAlgSHA256)
+	require.NoError(t, err)
+	r := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(payload))
+	r.Header.Set("X-Payload-Digest-Alg", WebhookDigestAlgSHA256)
+	r.Header.Set("X-Payload-Digest", digest)
+	return r
+}
+
+func TestRegistry(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		tokens []string
+	)
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		tokens = append(tokens, r.Header.Get("X-App-Token"))
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{"reviewStatus":"pending"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	reg := NewRegistry(WithRegistryHTTPClient(srv.Client()), WithRegistryClientOpts(WithHost(srv.Listener.Addr().String())))
+	require.NoError(t, reg.Set("eu", Profile{AppToken: "prd:eu", SecretKey: "eu-secret", WebhookSecret: "eu-webhook"}))
+	require.NoError(t, reg.Set("us", Profile{AppToken: "sbx:us", SecretKey: "us-secret", WebhookSecret: "us-webhook"}))
+	assert.Equal(t, []string{"eu", "us"}, reg.Tenants())
+
+	err := reg.Set("bad", Profile{Environment: EnvironmentProduction, AppToken: "sbx:bad", SecretKey: "secret"})
+	assert.True(t, errors.Is(err, ErrTokenEnvironmentMismatch))
+
+	eu, err := reg.Client("eu")
+	require.NoError(t, err)
+	us, err := reg.Client("us")
+	require.NoError(t, err)
+	assert.Same(t, eu.cli, us.cli)
+	assert.True(t, us.sandbox)
+
+	_, err = eu.ApplicantReviewStatus(context.Background(), ApplicantReviewStatusRequest{ApplicantID: "app-1"})
+	require.NoError(t, err)
+
+	// rotation
+	require.NoError(t, reg.Set("eu", Profile{AppToken: "prd:eu-rotated", SecretKey: "eu-secret-2", WebhookSecret: "eu-webhook-2"}))
+	eu, err = reg.Client("eu")
+	require.NoError(t, err)
+	_, err = eu.ApplicantReviewStatus(context.Background(), ApplicantReviewStatusRequest{ApplicantID: "app-1"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"prd:eu", "prd:eu-rotated"}, tokens)
+
+	reg.Remove("us")
+	_, err = reg.Client("us")
+	assert.True(t, errors.Is(err, ErrUnknownTenant))
+}
+
+func TestRegistry_VerifyWebhook(t *testing.T) {
+	var rejected []error
+	reg := NewRegistry(WithRegistryTenantHeader("X-Tenant"), WithRegistryWebhookErrorHandler(func(err error) {
+		rejected = append(rejected, err)
+	}))
+	require.NoError(t, reg.Set("eu", Profile{AppToken: "prd:eu", SecretKey: "secret", WebhookSecret: "eu-webhook"}))
+	require.NoError(t, reg.Set("us", Profile{AppToken: "prd:us", SecretKey: "secret", WebhookSecret: "us-webhook"}))
+	const payload = `{"applicantId":"app-1","type":"applicantReviewed","reviewStatus":"completed"}`
+
+	tenant, body, err := reg.VerifyWebhook(signedWebhookRequest(t, payload, "us-webhook"))
+	require.NoError(t, err)
+	assert.Equal(t, "us", tenant)
+	assert.Equal(t, payload, string(body))
+
+	r := signedWebhookRequest(t, payload, "us-webhook")
+	r.Header.Set("X-Tenant", "eu")
+	_, _, err = reg.VerifyWebhook(r)
+	assert.EqualError(t, err, "tenant eu: digest mismatch")
+
+	r = signedWebhookRequest(t, payload, "eu-webhook")
+	r.Header.Set("X-Tenant", "eu")
+	tenant, _, err = reg.VerifyWebhook(r)
+	require.NoError(t, err)
+	assert.Equal(t, "eu", tenant)
+
+	_, _, err = reg.VerifyWebhook(signedWebhookRequest(t, payload, "unknown"))
+	assert.Equal(t, ErrNoTenantMatched, err)
+
+	var got []string
+	h := reg.WebhookHandler(func(tenant string, wh Webhook) {
+		got = append(got, tenant+":"+wh.ApplicantID)
+	})
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, signedWebhookRequest(t, payload, "eu-webhook"))
+	assert.Equal(t, http.StatusOK, w.Code)
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, signedWebhookRequest(t, payload, "unknown"))
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	r = signedWebhookRequest(t, payload, "eu-webhook")
+	r.Header.Set("X-Tenant", "<script>")
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Equal(t, "Unauthorized\n", w.Body.String())
+	assert.Equal(t, []string{"eu:app-1"}, got)
+	require.Len(t, rejected, 2)
+	assert.True(t, errors.Is(rejected[0], ErrNoTenantMatched))
+	assert.EqualError(t, rejected[1], "verify webhook: unknown tenant: <script>")
+
+	// shared secret can't be routed by the digest, only by the header
+	require.NoError(t, reg.Set("apac", Profile{AppToken: "prd:apac", SecretKey: "secret", WebhookSecret: "eu-webhook"}))
+	_, _, err = reg.VerifyWebhook(signedWebhookRequest(t, payload, "eu-webhook"))
+	assert.True(t, errors.Is(err, ErrAmbiguousTenant))
+	assert.EqualError(t, err, "several tenants matched webhook: apac, eu")
+	r = signedWebhookRequest(t, payload, "eu-webhook")
+	r.Header.Set("X-Tenant", "apac")
+	tenant, _, err = reg.VerifyWebhook(r)
+	require.NoError(t, err)
+	assert.Equal(t, "apac", tenant)
+}
+
+func TestRegistry_WebhookSecretRotation(t *testing.T) {
+	now := time.Date(2023, 2, 6, 7, 0, 0, 0, time.UTC)
+	reg := NewRegistry(
+		WithRegistryTenantHeader("X-Tenant"),
+		WithRegistryWebhookSecretGrace(time.Minute),
+		WithRegistryNowFunc(func() time.Time { return now }),
+	)
+	const payload = `{"applicantId":"app-1","type":"applicantReviewed"}`
+	verify := func(secret, header string) (string, error) {
+		r := signedWebhookRequest(t, payload, secret)
+		r.Header.Set("X-Tenant", header)
+		tenant, _, err := reg.VerifyWebhook(r)
+		return tenant, err
+	}
+
+	require.NoError(t, reg.Set("eu", Profile{AppToken: "prd:eu", SecretKey: "secret", WebhookSecret: "webhook-1"}))
+	require.NoError(t, reg.Set("eu", Profile{AppToken: "prd:eu", SecretKey: "secret", WebhookSecret: "webhook-2"}))
+	for _, header := range []string{"", "eu"} {
+		for _, secret := range []string{"webhook-1", "webhook-2"} {
+			tenant, err := verify(secret, header)
+			require.NoError(t, err, secret)
+			assert.Equal(t, "eu", tenant)
+		}
+	}
+
+	// the grace period survives Set with the same secret
+	now = now.Add(30 * time.Second)
+	require.NoError(t, reg.Set("eu", Profile{AppToken: "prd:eu-2", SecretKey: "secret", WebhookSecret: "webhook-2"}))
+	_, err := verify("webhook-1", "eu")
+	assert.NoError(t, err)
+
+	now = now.Add(time.Minute)
+	_, err = verify("webhook-1", "eu")
+	assert.EqualError(t, err, "tenant eu: digest mismatch")
+	_, err = verify("webhook-1", "")
+	assert.Equal(t, ErrNoTenantMatched, err)
+	_, err = verify("webhook-2", "")
+	assert.NoError(t, err)
+}
+
+func TestRegistry_Concurrent(t *testing.T) {
+	reg := NewRegistry()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			assert.NoError(t, reg.Set("eu", Profile{AppToken: fmt.Sprintf("prd:%d", i), SecretKey: "secret", WebhookSecret: "webhook"}))
+		}(i)
+		go func() {
+			defer wg.Done()
+			_, _ = reg.Client("eu")
+			_, _, _ = reg.VerifyWebhook(signedWebhookRequest(t, `{}`, "webhook"))
+		}()
+	}
+	wg.Wait()
+	_, err := reg.Client("eu")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,448 @@
+package sumsub
+
+import (
+	"sort"
+)
+
+// RejectLabel reason of the applicant rejection returned in ReviewResult.RejectLabels.
+// https://docs.sumsub.com/reference/rejected
+type RejectLabel string
+
+// RejectLabelCategory groups reject labels by the nature of the rejection.
+type RejectLabelCategory string
+
+const (
+	RejectLabelCategoryFraud      RejectLabelCategory = "fraud"      // Attempt of fraud or identity abuse.
+	RejectLabelCategoryQuality    RejectLabelCategory = "quality"    // Insufficient or unsuitable data provided by the applicant.
+	RejectLabelCategoryCompliance RejectLabelCategory = "compliance" // Applicant does not meet regulatory or client requirements.
+)
+
+const (
+	// fraud
+	RejectLabelForgery             RejectLabel = "FORGERY"
+	RejectLabelDocumentTemplate    RejectLabel = "DOCUMENT_TEMPLATE"
+	RejectLabelFraudulentPatterns  RejectLabel = "FRAUDULENT_PATTERNS"
+	RejectLabelFraudulentLiveness  RejectLabel = "FRAUDULENT_LIVENESS"
+	RejectLabelSelfieMismatch      RejectLabel = "SELFIE_MISMATCH"
+	RejectLabelDuplicate           RejectLabel = "DUPLICATE"
+	RejectLabelInconsistentProfile RejectLabel = "INCONSISTENT_PROFILE"
+	RejectLabelThirdPartyInvolved  RejectLabel = "THIRD_PARTY_INVOLVED"
+	RejectLabelSpam                RejectLabel = "SPAM"
+	RejectLabelBlacklist           RejectLabel = "BLACKLIST"
+	RejectLabelBlocklist           RejectLabel = "BLOCKLIST"
+	RejectLabelGraphicEditor       RejectLabel = "GRAPHIC_EDITOR"
+
+	// quality
+	RejectLabelLowQuality               RejectLabel = "LOW_QUALITY"
+	RejectLabelUnsatisfactoryPhotos     RejectLabel = "UNSATISFACTORY_PHOTOS"
+	RejectLabelScreenshots              RejectLabel = "SCREENSHOTS"
+	RejectLabelBlackAndWhite            RejectLabel = "BLACK_AND_WHITE"
+	RejectLabelDocumentPageMissing      RejectLabel = "DOCUMENT_PAGE_MISSING"
+	RejectLabelFrontSideMissing         RejectLabel = "FRONT_SIDE_MISSING"
+	RejectLabelBackSideMissing          RejectLabel = "BACK_SIDE_MISSING"
+	RejectLabelDocumentDamaged          RejectLabel = "DOCUMENT_DAMAGED"
+	RejectLabelDocumentMissing          RejectLabel = "DOCUMENT_MISSING"
+	RejectLabelIncompleteDocument       RejectLabel = "INCOMPLETE_DOCUMENT"
+	RejectLabelUnsuitableDocument       RejectLabel = "UNSUITABLE_DOCUMENT"
+	RejectLabelNotDocument              RejectLabel = "NOT_DOCUMENT"
+	RejectLabelExpirationDate           RejectLabel = "EXPIRATION_DATE"
+	RejectLabelIDInvalid                RejectLabel = "ID_INVALID"
+	RejectLabelBadSelfie                RejectLabel = "BAD_SELFIE"
+	RejectLabelBadVideoSelfie           RejectLabel = "BAD_VIDEO_SELFIE"
+	RejectLabelBadFaceMatching          RejectLabel = "BAD_FACE_MATCHING"
+	RejectLabelBadProofOfIdentity       RejectLabel = "BAD_PROOF_OF_IDENTITY"
+	RejectLabelBadProofOfAddress        RejectLabel = "BAD_PROOF_OF_ADDRESS"
+	RejectLabelBadProofOfPayment        RejectLabel = "BAD_PROOF_OF_PAYMENT"
+	RejectLabelProblematicApplicantData RejectLabel = "PROBLEMATIC_APPLICANT_DATA"
+	RejectLabelRequestedDataMismatch    RejectLabel = "REQUESTED_DATA_MISMATCH"
+	RejectLabelDBDataMismatch           RejectLabel = "DB_DATA_MISMATCH"
+	RejectLabelDBDataNotFound           RejectLabel = "DB_DATA_NOT_FOUND"
+
+	// compliance
+	RejectLabelAdverseMedia                  RejectLabel = "ADVERSE_MEDIA"
+	RejectLabelCriminal                      RejectLabel = "CRIMINAL"
+	RejectLabelPEP                           RejectLabel = "PEP"
+	RejectLabelSanctions                     RejectLabel = "SANCTIONS"
+	RejectLabelRegulationsViolations         RejectLabel = "REGULATIONS_VIOLATIONS"
+	RejectLabelWrongUserRegion               RejectLabel = "WRONG_USER_REGION"
+	RejectLabelCheckUnavailable              RejectLabel = "CHECK_UNAVAILABLE"
+	RejectLabelCompromisedPersons            RejectLabel = "COMPROMISED_PERSONS"
+	RejectLabelExperienceRequirementMismatch RejectLabel = "EXPERIENCE_REQUIREMENT_MISMATCH"
+	RejectLabelAgeRequirementMismatch        RejectLabel = "AGE_REQUIREMENT_MISMATCH"
+)
+
+type (
+	// RejectLabelInfo catalog entry of the reject label.
+	RejectLabelInfo struct {
+		Label     RejectLabel
+		Category  RejectLabelCategory
+		Retryable bool
+		// Explanations user-facing explanations keyed by Lang* constants.
+		Explanations map[string]string
+	}
+)
+
+// rejectLabelUnknownExplanations used for labels missing in the catalog.
+var rejectLabelUnknownExplanations = map[string]string{
+	LangEnglish: "We could not verify your profile.",
+	LangGerman:  "Wir konnten Ihr Profil nicht verifizieren.",
+	LangSpanish: "No pudimos verificar su perfil.",
+	LangRussian: "Нам не удалось верифицировать ваш профиль.",
+}
+
+var rejectLabels = map[RejectLabel]RejectLabelInfo{
+	RejectLabelForgery: {Category: RejectLabelCategoryFraud, Explanations: map[string]string{
+		LangEnglish: "The document appears to be forged.",
+		LangGerman:  "Das Dokument scheint gefälscht zu sein.",
+		LangSpanish: "El documento parece estar falsificado.",
+		LangRussian: "Документ выглядит поддельным.",
+	}},
+	RejectLabelDocumentTemplate: {Category: RejectLabelCategoryFraud, Explanations: map[string]string{
+		LangEnglish: "The document looks like a template downloaded from the internet.",
+		LangGerman:  "Das Dokument sieht aus wie eine aus dem Internet heruntergeladene Vorlage.",
+		LangSpanish: "El documento parece una plantilla descargada de internet.",
+		LangRussian: "Документ похож на шаблон, скачанный из интернета.",
+	}},
+	RejectLabelFraudulentPatterns: {Category: RejectLabelCategoryFraud, Explanations: map[string]string{
+		LangEnglish: "Signs of fraudulent behavior were detected.",
+		LangGerman:  "Es wurden Anzeichen für betrügerisches Verhalten festgestellt.",
+		LangSpanish: "Se detectaron indicios de comportamiento fraudulento.",
+		LangRussian: "Обнаружены признаки мошеннических действий.",
+	}},
+	RejectLabelFraudulentLiveness: {Category: RejectLabelCategoryFraud, Explanations: map[string]string{
+		LangEnglish: "The liveness check was not passed.",
+		LangGerman:  "Die Lebendigkeitsprüfung wurde nicht bestanden.",
+		LangSpanish: "No se superó la prueba de vida.",
+		LangRussian: "Проверка на живость не пройдена.",
+	}},
+	RejectLabelSelfieMismatch: {Category: RejectLabelCategoryFraud, Explanations: map[string]string{
+		LangEnglish: "The selfie does not match the photo in the document.",
+		LangGerman:  "Das Selfie stimmt nicht mit dem Foto im Dokument überein.",
+		LangSpanish: "El selfie no coincide con la foto del documento.",
+		LangRussian: "Селфи не совпадает с фотографией в документе.",
+	}},
+	RejectLabelDuplicate: {Category: RejectLabelCategoryFraud, Explanations: map[string]string{
+		LangEnglish: "A profile with the same data already exists.",
+		LangGerman:  "Ein Profil mit denselben Daten existiert bereits.",
+		LangSpanish: "Ya existe un perfil con los mismos datos.",
+		LangRussian: "Профиль с такими же данными уже существует.",
+	}},
+	RejectLabelInconsistentProfile: {Category: RejectLabelCategoryFraud, Explanations: map[string]string{
+		LangEnglish: "The submitted data belongs to different persons.",
+		LangGerman:  "Die übermittelten Daten gehören zu verschiedenen Personen.",
+		LangSpanish: "Los datos enviados pertenecen a distintas personas.",
+		LangRussian: "Предоставленные данные принадлежат разным людям.",
+	}},
+	RejectLabelThirdPartyInvolved: {Category: RejectLabelCategoryFraud, Explanations: map[string]string{
+		LangEnglish: "A third party is involved in the verification.",
+		LangGerman:  "An der Verifizierung ist eine dritte Partei beteiligt.",
+		LangSpanish: "Un tercero está involucrado en la verificación.",
+		LangRussian: "В прохождении верификации участвует третье лицо.",
+	}},
+	RejectLabelSpam: {Category: RejectLabelCategoryFraud, Explanations: map[string]string{
+		LangEnglish: "Too many irrelevant files were uploaded.",
+		LangGerman:  "Es wurden zu viele irrelevante Dateien hochgeladen.",
+		LangSpanish: "Se subieron demasiados archivos irrelevantes.",
+		LangRussian: "Загружено слишком много нерелевантных файлов.",
+	}},
+	RejectLabelBlacklist: {Category: RejectLabelCategoryFraud, Explanations: map[string]string{
+		LangEnglish: "The profile is on the blocklist.",
+		LangGerman:  "Das Profil steht auf der Sperrliste.",
+		LangSpanish: "El perfil está en la lista de bloqueo.",
+		LangRussian: "Профиль находится в черном списке.",
+	}},
+	RejectLabelBlocklist: {Category: RejectLabelCategoryFraud, Explanations: map[string]string{
+		LangEnglish: "The profile is on the blocklist.",
+		LangGerman:  "Das Profil steht auf der Sperrliste.",
+		LangSpanish: "El perfil está en la lista de bloqueo.",
+		LangRussian: "Профиль находится в черном списке.",
+	}},
+	RejectLabelGraphicEditor: {Category: RejectLabelCategoryFraud, Explanations: map[string]string{
+		LangEnglish: "The document was edited in a graphic editor.",
+		LangGerman:  "Das Dokument wurde mit einem Grafikprogramm bearbeitet.",
+		LangSpanish: "El documento fue editado con un editor gráfico.",
+		LangRussian: "Документ был отредактирован в графическом редакторе.",
+	}},
+	RejectLabelCompromisedPersons: {Category: RejectLabelCategoryCompliance, Explanations: map[string]string{
+		LangEnglish: "The profile is linked to compromised persons.",
+		LangGerman:  "Das Profil ist mit kompromittierten Personen verbunden.",
+		LangSpanish: "El perfil está vinculado a personas comprometidas.",
+		LangRussian: "Профиль связан со скомпрометированными лицами.",
+	}},
+	RejectLabelExperienceRequirementMismatch: {Category: RejectLabelCategoryCompliance, Explanations: map[string]string{
+		LangEnglish: "The required level of experience is not met.",
+		LangGerman:  "Die erforderliche Erfahrung ist nicht gegeben.",
+		LangSpanish: "No se cumple el nivel de experiencia requerido.",
+		LangRussian: "Не соответствует требуемому уровню опыта.",
+	}},
+	RejectLabelAgeRequirementMismatch: {Category: RejectLabelCategoryCompliance, Explanations: map[string]string{
+		LangEnglish: "The age requirement is not met.",
+		LangGerman:  "Die Altersanforderung ist nicht erfüllt.",
+		LangSpanish: "No se cumple el requisito de edad.",
+		LangRussian: "Не соответствует возрастным требованиям.",
+	}},
+	RejectLabelLowQuality: {Category: RejectLabelCategoryQuality, Retryable: true, Explanations: map[string]string{
+		LangEnglish: "The document quality is too low. Please upload a clear image.",
+		LangGerman:  "Die Qualität des Dokuments ist zu niedrig. Bitte laden Sie ein klares Bild hoch.",
+		LangSpanish: "La calidad del documento es demasiado baja. Suba una imagen nítida.",
+		LangRussian: "Слишком низкое качество документа. Загрузите четкое изображение.",
+	}},
+	RejectLabelUnsatisfactoryPhotos: {Category: RejectLabelCategoryQuality, Retryable: true, Explanations: map[string]string{
+		LangEnglish: "The photos are not readable. Please upload new photos.",
+		LangGerman:  "Die Fotos sind nicht lesbar. Bitte laden Sie neue Fotos hoch.",
+		LangSpanish: "Las fotos no son legibles. Suba nuevas fotos.",
+		LangRussian: "Фотографии нечитаемы. Загрузите новые фотографии.",
+	}},
+	RejectLabelScreenshots: {Category: RejectLabelCategoryQuality, Retryable: true, Explanations: map[string]string{
+		LangEnglish: "Screenshots are not accepted. Please upload a photo of the original document.",
+		LangGerman:  "Screenshots werden nicht akzeptiert. Bitte laden Sie ein Foto des Originaldokuments hoch.",
+		LangSpanish: "No se aceptan capturas de pantalla. Suba una foto del documento original.",
+		LangRussian: "Скриншоты не принимаются. Загрузите фотографию оригинала документа.",
+	}},
+	RejectLabelBlackAndWhite: {Category: RejectLabelCategoryQuality, Retryable: true, Explanations: map[string]string{
+		LangEnglish: "Black and white images are not accepted. Please upload a color image.",
+		LangGerman:  "Schwarz-Weiß-Bilder werden nicht akzeptiert. Bitte laden Sie ein Farbbild hoch.",
+		LangSpanish: "No se aceptan imágenes en blanco y negro. Suba una imagen en color.",
+		LangRussian: "Черно-белые изображения не принимаются. Загрузите цветное изображение.",
+	}},
+	RejectLabelDocumentPageMissing: {Category: RejectLabelCategoryQuality, Retryable: true, Explanations: map[string]string{
+		LangEnglish: "Some pages of the document are missing.",
+		LangGerman:  "Einige Seiten des Dokuments fehlen.",
+		LangSpanish: "Faltan algunas páginas del documento.",
+		LangRussian: "Отсутствуют некоторые страницы документа.",
+	}},
+	RejectLabelFrontSideMissing: {Category: RejectLabelCategoryQuality, Retryable: true, Explanations: map[string]string{
+		LangEnglish: "The front side of the document is missing.",
+		LangGerman:  "Die Vorderseite des Dokuments fehlt.",
+		LangSpanish: "Falta el anverso del documento.",
+		LangRussian: "Отсутствует лицевая сторона документа.",
+	}},
+	RejectLabelBackSideMissing: {Category: RejectLabelCategoryQuality, Retryable: true, Explanations: map[string]string{
+		LangEnglish: "The back side of the document is missing.",
+		LangGerman:  "Die Rückseite des Dokuments fehlt.",
+		LangSpanish: "Falta el reverso del documento.",
+		LangRussian: "Отсутствует оборотная сторона документа.",
+	}},
+	RejectLabelDocumentDamaged: {Category: RejectLabelCategoryQuality, Retryable: true, Explanations: map[string]string{
+		LangEnglish: "The document is damaged.",
+		LangGerman:  "Das Dokument ist beschädigt.",
+		LangSpanish: "El documento está dañado.",
+		LangRussian: "Документ поврежден.",
+	}},
+	RejectLabelDocumentMissing: {Category: RejectLabelCategoryQuality, Retryable: true, Explanations: map[string]string{
+		LangEnglish: "A required document is missing.",
+		LangGerman:  "Ein erforderliches Dokument fehlt.",
+		LangSpanish: "Falta un documento requerido.",
+		LangRussian: "Отсутствует обязательный документ.",
+	}},
+	RejectLabelIncompleteDocument: {Category: RejectLabelCategoryQuality, Retryable: true, Explanations: map[string]string{
+		LangEnglish: "The document is not fully visible.",
+		LangGerman:  "Das Dokument ist nicht vollständig sichtbar.",
+		LangSpanish: "El documento no es completamente visible.",
+		LangRussian: "Документ виден не полностью.",
+	}},
+	RejectLabelUnsuitableDocument: {Category: RejectLabelCategoryQuality, Retryable: true, Explanations: map[string]string{
+		LangEnglish: "The document type is not accepted.",
+		LangGerman:  "Der Dokumenttyp wird nicht akzeptiert.",
+		LangSpanish: "El tipo de documento no es aceptado.",
+		LangRussian: "Данный тип документа не принимается.",
+	}},
+	RejectLabelNotDocument: {Category: RejectLabelCategoryQuality, Retryable: true, Explanations: map[string]string{
+		LangEnglish: "The uploaded file is not a document.",
+		LangGerman:  "Die hochgeladene Datei ist kein Dokument.",
+		LangSpanish: "El archivo subido no es un documento.",
+		LangRussian: "Загруженный файл не является документом.",
+	}},
+	RejectLabelExpirationDate: {Category: RejectLabelCategoryQuality, Retryable: true, Explanations: map[string]string{
+		LangEnglish: "The document has expired.",
+		LangGerman:  "Das Dokument ist abgelaufen.",
+		LangSpanish: "El documento ha caducado.",
+		LangRussian: "Срок действия документа истек.",
+	}},
+	RejectLabelIDInvalid: {Category: RejectLabelCategoryQuality, Retryable: true, Explanations: map[string]string{
+		LangEnglish: "The identity document is not valid.",
+		LangGerman:  "Das Ausweisdokument ist ungültig.",
+		LangSpanish: "El documento de identidad no es válido.",
+		LangRussian: "Документ, удостоверяющий личность, недействителен.",
+	}},
+	RejectLabelBadSelfie: {Category: RejectLabelCategoryQuality, Retryable: true, Explanations: map[string]string{
+		LangEnglish: "The selfie is not suitable. Please take a new one.",
+		LangGerman:  "Das Selfie ist nicht geeignet. Bitte nehmen Sie ein neues auf.",
+		LangSpanish: "El selfie no es adecuado. Tome uno nuevo.",
+		LangRussian: "Селфи не подходит. Сделайте новое.",
+	}},
+	RejectLabelBadVideoSelfie: {Category: RejectLabelCategoryQuality, Retryable: true, Explanations: map[string]string{
+		LangEnglish: "The video selfie is not suitable. Please record a new one.",
+		LangGerman:  "Das Video-Selfie ist nicht geeignet. Bitte nehmen Sie ein neues auf.",
+		LangSpanish: "El video selfie no es adecuado. Grabe uno nuevo.",
+		LangRussian: "Видеоселфи не подходит. Запишите новое.",
+	}},
+	RejectLabelBadFaceMatching: {Category: RejectLabelCategoryQuality, Retryable: true, Explanations: map[string]string{
+		LangEnglish: "The face could not be matched with the document photo.",
+		LangGerman:  "Das Gesicht konnte nicht mit dem Dokumentfoto abgeglichen werden.",
+		LangSpanish: "No se pudo comparar el rostro con la foto del documento.",
+		LangRussian: "Не удалось сопоставить лицо с фотографией в документе.",
+	}},
+	RejectLabelBadProofOfIdentity: {Category: RejectLabelCategoryQuality, Retryable: true, Explanations: map[string]string{
+		LangEnglish: "The proof of identity is not acceptable.",
+		LangGerman:  "Der Identitätsnachweis ist nicht akzeptabel.",
+		LangSpanish: "El comprobante de identidad no es aceptable.",
+		LangRussian: "Подтверждение личности не принято.",
+	}},
+	RejectLabelBadProofOfAddress: {Category: RejectLabelCategoryQuality, Retryable: true, Explanations: map[string]string{
+		LangEnglish: "The proof of address is not acceptable.",
+		LangGerman:  "Der Adressnachweis ist nicht akzeptabel.",
+		LangSpanish: "El comprobante de domicilio no es aceptable.",
+		LangRussian: "Подтверждение адреса не принято.",
+	}},
+	RejectLabelBadProofOfPayment: {Category: RejectLabelCategoryQuality, Retryable: true, Explanations: map[string]string{
+		LangEnglish: "The proof of payment is not acceptable.",
+		LangGerman:  "Der Zahlungsnachweis ist nicht akzeptabel.",
+		LangSpanish: "El comprobante de pago no es aceptable.",
+		LangRussian: "Подтверждение платежа не принято.",
+	}},
+	RejectLabelProblematicApplicantData: {Category: RejectLabelCategoryQuality, Retryable: true, Explanations: map[string]string{
+		LangEnglish: "The profile data does not match the documents.",
+		LangGerman:  "Die Profildaten stimmen nicht mit den Dokumenten überein.",
+		LangSpanish: "Los datos del perfil no coinciden con los documentos.",
+		LangRussian: "Данные профиля не совпадают с документами.",
+	}},
+	RejectLabelRequestedDataMismatch: {Category: RejectLabelCategoryQuality, Retryable: true, Explanations: map[string]string{
+		LangEnglish: "The provided data does not match the requested data.",
+		LangGerman:  "Die angegebenen Daten stimmen nicht mit den angeforderten überein.",
+		LangSpanish: "Los datos proporcionados no coinciden con los solicitados.",
+		LangRussian: "Предоставленные данные не совпадают с запрошенными.",
+	}},
+	RejectLabelDBDataMismatch: {Category: RejectLabelCategoryQuality, Retryable: true, Explanations: map[string]string{
+		LangEnglish: "The data does not match the records in the database.",
+		LangGerman:  "Die Daten stimmen nicht mit den Datenbankeinträgen überein.",
+		LangSpanish: "Los datos no coinciden con los registros de la base de datos.",
+		LangRussian: "Данные не совпадают с записями в базе данных.",
+	}},
+	RejectLabelDBDataNotFound: {Category: RejectLabelCategoryQuality, Retryable: true, Explanations: map[string]string{
+		LangEnglish: "The data was not found in the database.",
+		LangGerman:  "Die Daten wurden in der Datenbank nicht gefunden.",
+		LangSpanish: "Los datos no se encontraron en la base de datos.",
+		LangRussian: "Данные не найдены в базе данных.",
+	}},
+	RejectLabelAdverseMedia: {Category: RejectLabelCategoryCompliance, Explanations: map[string]string{
+		LangEnglish: "Adverse media mentions were found.",
+		LangGerman:  "Es wurden negative Medienberichte gefunden.",
+		LangSpanish: "Se encontraron menciones negativas en medios.",
+		LangRussian: "Найдены негативные упоминания в СМИ.",
+	}},
+	RejectLabelCriminal: {Category: RejectLabelCategoryCompliance, Explanations: map[string]string{
+		LangEnglish: "The applicant is involved in illegal actions.",
+		LangGerman:  "Der Antragsteller ist an illegalen Handlungen beteiligt.",
+		LangSpanish: "El solicitante está involucrado en actividades ilegales.",
+		LangRussian: "Заявитель причастен к противоправным действиям.",
+	}},
+	RejectLabelPEP: {Category: RejectLabelCategoryCompliance, Explanations: map[string]string{
+		LangEnglish: "The applicant is a politically exposed person.",
+		LangGerman:  "Der Antragsteller ist eine politisch exponierte Person.",
+		LangSpanish: "El solicitante es una persona políticamente expuesta.",
+		LangRussian: "Заявитель является политически значимым лицом.",
+	}},
+	RejectLabelSanctions: {Category: RejectLabelCategoryCompliance, Explanations: map[string]string{
+		LangEnglish: "The applicant is on a sanctions list.",
+		LangGerman:  "Der Antragsteller steht auf einer Sanktionsliste.",
+		LangSpanish: "El solicitante figura en una lista de sanciones.",
+		LangRussian: "Заявитель находится в санкционном списке.",
+	}},
+	RejectLabelRegulationsViolations: {Category: RejectLabelCategoryCompliance, Explanations: map[string]string{
+		LangEnglish: "The verification can not be completed due to regulatory requirements.",
+		LangGerman:  "Die Verifizierung kann aufgrund regulatorischer Anforderungen nicht abgeschlossen werden.",
+		LangSpanish: "La verificación no puede completarse debido a requisitos regulatorios.",
+		LangRussian: "Верификация не может быть завершена из-за регуляторных требований.",
+	}},
+	RejectLabelWrongUserRegion: {Category: RejectLabelCategoryCompliance, Explanations: map[string]string{
+		LangEnglish: "Applicants from your region are not accepted.",
+		LangGerman:  "Antragsteller aus Ihrer Region werden nicht akzeptiert.",
+		LangSpanish: "No se aceptan solicitantes de su región.",
+		LangRussian: "Заявители из вашего региона не принимаются.",
+	}},
+	RejectLabelCheckUnavailable: {Category: RejectLabelCategoryCompliance, Retryable: true, Explanations: map[string]string{
+		LangEnglish: "The check is temporarily unavailable.",
+		LangGerman:  "Die Prüfung ist vorübergehend nicht verfügbar.",
+		LangSpanish: "La verificación no está disponible temporalmente.",
+		LangRussian: "Проверка временно недоступна.",
+	}},
+}
+
+// RejectLabels returns all labels from the catalog sorted by label.
+func RejectLabels() []RejectLabelInfo {
+	infos := make([]RejectLabelInfo, 0, len(rejectLabels))
+	for l := range rejectLabels {
+		info, _ := LookupRejectLabel(l)
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Label < infos[j].Label
+	})
+	return infos
+}
+
+// LookupRejectLabel returns the catalog entry of the label.
+func LookupRejectLabel(l RejectLabel) (RejectLabelInfo, bool) {
+	info, ok := rejectLabels[l]
+	if !ok {
+		return RejectLabelInfo{}, false
+	}
+	explanations := make(map[string]string, len(info.Explanations))
+	for lang, e := range info.Explanations {
+		explanations[lang] = e
+	}
+	info.Label = l
+	info.Explanations = explanations
+	return info, true
+}
+
+// Explanations returns user-facing explanations of the reject labels in the lang, duplicates are skipped.
+func (r ReviewResult) Explanations(lang string) []string {
+	var (
+		res  []string
+		seen = make(map[string]struct{}, len(r.RejectLabels))
+	)
+	for _, l := range r.RejectLabels {
+		e := l.Explanation(lang)
+		if _, ok := seen[e]; ok {
+			continue
+		}
+		seen[e] = struct{}{}
+		res = append(res, e)
+	}
+	return res
+}
+
+// IsKnown reports whether the label is in the catalog.
+func (l RejectLabel) IsKnown() bool {
+	_, ok := rejectLabels[l]
+	return ok
+}
+
+// Category returns the label category, empty for unknown labels.
+func (l RejectLabel) Category() RejectLabelCategory {
+	return rejectLabels[l].Category
+}
+
+// Retryable reports whether the applicant could fix the reason and resubmit the data.
+func (l RejectLabel) Retryable() bool {
+	return rejectLabels[l].Retryable
+}
+
+// Explanation returns the user-facing explanation in the lang (one of Lang* constants).
+// Falls back to the English explanation and to a generic message for unknown labels.
+func (l RejectLabel) Explanation(lang string) string {
+	explanations := rejectLabelUnknownExplanations
+	if info, ok := rejectLabels[l]; ok {
+		explanations = info.Explanations
+	}
+	if e, ok := explanations[lang]; ok {
+		return e
+	}
+	return explanations[LangEnglish]
+}
+
+func (l RejectLabel) String() string {
+	return string(l)
+}
@@ -0,0 +1,39 @@
+package sumsub
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRejectLabelCatalog(t *testing.T) {
+	for _, info := range RejectLabels() {
+		assert.NotEmpty(t, info.Category, info.Label)
+		assert.NotEmpty(t, info.Explanations[LangEnglish], info.Label)
+	}
+
+	info, ok := LookupRejectLabel(RejectLabelForgery)
+	assert.True(t, ok)
+	assert.Equal(t, RejectLabelForgery, info.Label)
+	assert.Equal(t, RejectLabelCategoryFraud, info.Category)
+	assert.False(t, info.Retryable)
+
+	assert.Equal(t, RejectLabelCategoryQuality, RejectLabelDocumentPageMissing.Category())
+	assert.True(t, RejectLabelDocumentPageMissing.Retryable())
+	assert.Equal(t, RejectLabelCategoryCompliance, RejectLabelSanctions.Category())
+
+	_, ok = LookupRejectLabel("SOMETHING_NEW")
+	assert.False(t, ok)
+	assert.False(t, RejectLabel("SOMETHING_NEW").IsKnown())
+	assert.Equal(t, RejectLabelCategory(""), RejectLabel("SOMETHING_NEW").Category())
+}
+
+func TestRejectLabelExplanation(t *testing.T) {
+	assert.Equal(t, "The proof of address is not acceptable.", RejectLabelBadProofOfAddress.Explanation(LangEnglish))
+	assert.Equal(t, "Der Adressnachweis ist nicht akzeptabel.", RejectLabelBadProofOfAddress.Explanation(LangGerman))
+	assert.Equal(t, "The proof of address is not acceptable.", RejectLabelBadProofOfAddress.Explanation(LangJapanese))
+	assert.Equal(t, "We could not verify your profile.", RejectLabel("SOMETHING_NEW").Explanation(LangEnglish))
+
+	r := ReviewResult{RejectLabels: []RejectLabel{RejectLabelBlacklist, RejectLabelBlocklist, RejectLabelForgery}}
+	assert.Equal(t, []string{"The profile is on the blocklist.", "The document appears to be forged."}, r.Explanations(LangEnglish))
+}
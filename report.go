@@ -0,0 +1,71 @@
+package sumsub
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// ReportType type of the summary report.
+type ReportType string
+
+const (
+	ReportTypeApplicant ReportType = "applicantReport"
+	ReportTypeCompany   ReportType = "companyReport"
+)
+
+type (
+	SummaryReportRequest struct {
+		ApplicantID string
+		ReportType  ReportType // ReportTypeApplicant if empty
+		Lang        string     // Lang* constants, LangEnglish if empty
+	}
+
+	// SummaryReportResponse caller must close Body.
+	SummaryReportResponse struct {
+		Body        io.ReadCloser
+		ContentType string
+		// ContentLength -1 if unknown.
+		ContentLength int64
+	}
+)
+
+// SummaryReport Use this method to download the applicant or company summary report PDF.
+// https://docs.sumsub.com/reference/generate-applicant-summary-report
+func (c *Client) SummaryReport(ctx context.Context, req SummaryReportRequest) (SummaryReportResponse, error) {
+	if req.ReportType == "" {
+		req.ReportType = ReportTypeApplicant
+	}
+	if req.ReportType != ReportTypeApplicant && req.ReportType != ReportTypeCompany {
+		return SummaryReportResponse{}, fmt.Errorf("unsupported report type: %s", req.ReportType)
+	}
+	if req.Lang == "" {
+		req.Lang = LangEnglish
+	}
+	if !IsSupportedLang(req.Lang) {
+		return SummaryReportResponse{}, fmt.Errorf("unsupported lang: %s", req.Lang)
+	}
+	resp, err := send(ctx, c,
+		http.MethodGet,
+		(&url.URL{
+			Path: fmt.Sprintf("/resources/applicants/%s/summary/report", url.PathEscape(req.ApplicantID)),
+			RawQuery: url.Values{
+				"report": {string(req.ReportType)},
+				"lang":   {req.Lang},
+			}.Encode(),
+		}).String(),
+		"application/json",
+		"application/pdf",
+		nil,
+	)
+	if err != nil {
+		return SummaryReportResponse{}, fmt.Errorf("call: %w", err)
+	}
+	return SummaryReportResponse{
+		Body:          resp.Body,
+		ContentType:   resp.Header.Get("Content-Type"),
+		ContentLength: resp.ContentLength,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package sumsub
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummaryReport(t *testing.T) {
+	cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/resources/applicants/5b594ade0a975a36c9349e66/summary/report", r.URL.Path)
+		assert.Equal(t, "application/pdf", r.Header.Get("Accept"))
+		if r.URL.Query().Get("report") == string(ReportTypeCompany) {
+			writeAPIError(w, http.StatusBadRequest, 0)
+			return
+		}
+		assert.Equal(t, LangGerman, r.URL.Query().Get("lang"))
+		w.Header().Set("Content-Type", "application/pdf")
+		_, _ = w.Write([]byte("%PDF-1.4"))
+	})
+
+	resp, err := cli.SummaryReport(context.Background(), SummaryReportRequest{
+		ApplicantID: "5b594ade0a975a36c9349e66",
+		Lang:        LangGerman,
+	})
+	require.NoError(t, err)
+	defer resp.Body.Close() //nolint: errcheck
+	assert.Equal(t, "application/pdf", resp.ContentType)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "%PDF-1.4", string(body))
+
+	_, err = cli.SummaryReport(context.Background(), SummaryReportRequest{
+		ApplicantID: "5b594ade0a975a36c9349e66",
+		ReportType:  ReportTypeCompany,
+	})
+	_, ok := AsAPIError(err)
+	assert.True(t, ok)
+
+	_, err = cli.SummaryReport(context.Background(), SummaryReportRequest{ApplicantID: "5b594ade0a975a36c9349e66", Lang: "xx"})
+	assert.EqualError(t, err, "unsupported lang: xx")
+}
@@ -0,0 +1,112 @@
+package sumsub
+
+// ReviewStatus applicant review status.
+// Unknown values are preserved as is, use IsKnown to check them.
+// https://docs.sumsub.com/reference/get-applicant-review-status
+type ReviewStatus string
+
+const (
+	ReviewStatusInit       ReviewStatus = "init"       // Initial registration has started. A client is still in the process of filling out the applicant profile.
+	ReviewStatusPending    ReviewStatus = "pending"    // An applicant is ready to be processed.
+	ReviewStatusPrechecked ReviewStatus = "prechecked" // The check is in a half way of being finished.
+	ReviewStatusQueued     ReviewStatus = "queued"     // The checks have been started for the applicant.
+	ReviewStatusCompleted  ReviewStatus = "completed"  // The check has been completed.
+	ReviewStatusOnHold     ReviewStatus = "onHold"     // Applicant waits for a final decision from compliance officer or waits for all beneficiaries to pass KYC.
+)
+
+// ReviewAnswer final answer of the review.
+type ReviewAnswer string
+
+const (
+	ReviewAnswerGreen ReviewAnswer = "GREEN" // Approved.
+	ReviewAnswerRed   ReviewAnswer = "RED"   // Rejected.
+)
+
+// ReviewRejectType type of the rejection, makes sense only for ReviewAnswerRed.
+type ReviewRejectType string
+
+const (
+	ReviewRejectTypeFinal    ReviewRejectType = "FINAL"    // Final reject, the applicant can't resubmit documents.
+	ReviewRejectTypeRetry    ReviewRejectType = "RETRY"    // Temporary reject, the applicant can upload new documents.
+	ReviewRejectTypeExternal ReviewRejectType = "EXTERNAL" // Rejected by an external decision (e.g. workflow or client side).
+)
+
+// IsKnown reports whether the status is one of the documented values.
+func (s ReviewStatus) IsKnown() bool {
+	switch s {
+	case ReviewStatusInit, ReviewStatusPending, ReviewStatusPrechecked, ReviewStatusQueued, ReviewStatusCompleted, ReviewStatusOnHold:
+		return true
+	}
+	return false
+}
+
+// IsFinal reports whether the review is completed and the result is available.
+func (s ReviewStatus) IsFinal() bool {
+	return s == ReviewStatusCompleted
+}
+
+// IsInProgress reports whether the applicant is being checked.
+func (s ReviewStatus) IsInProgress() bool {
+	return s == ReviewStatusPending || s == ReviewStatusPrechecked || s == ReviewStatusQueued
+}
+
+func (s ReviewStatus) String() string {
+	return string(s)
+}
+
+// IsKnown reports whether the answer is one of the documented values.
+func (a ReviewAnswer) IsKnown() bool {
+	return a == ReviewAnswerGreen || a == ReviewAnswerRed
+}
+
+// IsApproved reports whether the applicant is approved.
+func (a ReviewAnswer) IsApproved() bool {
+	return a == ReviewAnswerGreen
+}
+
+// IsRejected reports whether the applicant is rejected.
+func (a ReviewAnswer) IsRejected() bool {
+	return a == ReviewAnswerRed
+}
+
+func (a ReviewAnswer) String() string {
+	return string(a)
+}
+
+// IsKnown reports whether the reject type is one of the documented values.
+func (t ReviewRejectType) IsKnown() bool {
+	switch t {
+	case ReviewRejectTypeFinal, ReviewRejectTypeRetry, ReviewRejectTypeExternal:
+		return true
+	}
+	return false
+}
+
+// IsFinal reports whether the rejection is final.
+func (t ReviewRejectType) IsFinal() bool {
+	return t == ReviewRejectTypeFinal
+}
+
+// CanRetry reports whether the applicant is allowed to resubmit the data.
+func (t ReviewRejectType) CanRetry() bool {
+	return t == ReviewRejectTypeRetry
+}
+
+func (t ReviewRejectType) String() string {
+	return string(t)
+}
+
+// IsApproved reports whether the applicant is approved.
+func (r ReviewResult) IsApproved() bool {
+	return r.ReviewAnswer.IsApproved()
+}
+
+// IsFinalRejected reports whether the applicant is rejected without ability to retry.
+func (r ReviewResult) IsFinalRejected() bool {
+	return r.ReviewAnswer.IsRejected() && r.ReviewRejectType.IsFinal()
+}
+
+// CanRetry reports whether the applicant is rejected and allowed to resubmit the data.
+func (r ReviewResult) CanRetry() bool {
+	return r.ReviewAnswer.IsRejected() && r.ReviewRejectType.CanRetry()
+}
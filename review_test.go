@@ -0,0 +1,43 @@
+package sumsub
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReviewEnumsJSON(t *testing.T) {
+	var r respReviewResult
+	err := json.Unmarshal([]byte(`{"reviewAnswer":"YELLOW","reviewRejectType":null,"rejectLabels":["FORGERY"]}`), &r)
+	require.NoError(t, err)
+	assert.Equal(t, ReviewAnswer("YELLOW"), r.ReviewAnswer)
+	assert.False(t, r.ReviewAnswer.IsKnown())
+	assert.Equal(t, ReviewRejectType(""), r.ReviewRejectType)
+
+	b, err := json.Marshal(r)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"moderationComment":"","clientComment":"","reviewAnswer":"YELLOW","rejectLabels":["FORGERY"],"reviewRejectType":""}`, string(b))
+
+	var s ReviewStatus
+	require.NoError(t, json.Unmarshal([]byte(`"onHold"`), &s))
+	assert.Equal(t, ReviewStatusOnHold, s)
+	assert.True(t, s.IsKnown())
+	assert.Error(t, json.Unmarshal([]byte(`1`), &s))
+}
+
+func TestReviewPredicates(t *testing.T) {
+	assert.True(t, ReviewStatusCompleted.IsFinal())
+	assert.False(t, ReviewStatusOnHold.IsFinal())
+	assert.True(t, ReviewStatusQueued.IsInProgress())
+	assert.False(t, ReviewStatus("unknown").IsKnown())
+
+	assert.True(t, ReviewResult{ReviewAnswer: ReviewAnswerGreen}.IsApproved())
+	assert.False(t, ReviewResult{ReviewAnswer: ReviewAnswerRed}.IsApproved())
+	assert.True(t, ReviewResult{ReviewAnswer: ReviewAnswerRed, ReviewRejectType: ReviewRejectTypeRetry}.CanRetry())
+	assert.False(t, ReviewResult{ReviewAnswer: ReviewAnswerRed, ReviewRejectType: ReviewRejectTypeFinal}.CanRetry())
+	assert.True(t, ReviewResult{ReviewAnswer: ReviewAnswerRed, ReviewRejectType: ReviewRejectTypeFinal}.IsFinalRejected())
+	assert.False(t, ReviewResult{ReviewAnswer: ReviewAnswerGreen, ReviewRejectType: ReviewRejectTypeFinal}.IsFinalRejected())
+	assert.True(t, ReviewRejectTypeExternal.IsKnown())
+}
@@ -0,0 +1,87 @@
+package sumsub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ErrNotSandbox sandbox only method is called by the client without WithSandbox option.
+var ErrNotSandbox = errors.New("sandbox mode required")
+
+type (
+	SimulateReviewRequest struct {
+		ApplicantID       string
+		ReviewAnswer      ReviewAnswer
+		RejectLabels      []RejectLabel    // required for RED
+		ReviewRejectType  ReviewRejectType // RED only
+		ModerationComment string
+		ClientComment     string
+	}
+)
+
+type (
+	reqSimulateReview struct {
+		ReviewAnswer      ReviewAnswer     `json:"reviewAnswer"`
+		RejectLabels      []RejectLabel    `json:"rejectLabels,omitempty"`
+		ReviewRejectType  ReviewRejectType `json:"reviewRejectType,omitempty"`
+		ModerationComment string           `json:"moderationComment,omitempty"`
+		ClientComment     string           `json:"clientComment,omitempty"`
+	}
+)
+
+// SimulateReview Use this method in the sandbox to complete the applicant review with the given result.
+// Returns ErrNotSandbox unless the client is created with WithSandbox, and for the production (prd:) app token
+// even with WithSandbox.
+// https://docs.sumsub.com/reference/simulate-review-response-in-sandbox
+func (c *Client) SimulateReview(ctx context.Context, req SimulateReviewRequest) error {
+	if !c.sandbox {
+		return ErrNotSandbox
+	}
+	token := c.token
+	if c.creds != nil {
+		creds, err := c.creds.Credentials(ctx)
+		if err != nil {
+			return fmt.Errorf("credentials: %w", err)
+		}
+		token = creds.AppToken
+	}
+	if strings.HasPrefix(token, tokenPrefixProduction) {
+		return fmt.Errorf("%w: production app token", ErrNotSandbox)
+	}
+	switch req.ReviewAnswer {
+	case ReviewAnswerGreen:
+		if len(req.RejectLabels) > 0 || req.ReviewRejectType != "" {
+			return fmt.Errorf("reject labels and type are not allowed for %s", req.ReviewAnswer)
+		}
+	case ReviewAnswerRed:
+		if len(req.RejectLabels) == 0 {
+			return fmt.Errorf("reject labels required for %s", req.ReviewAnswer)
+		}
+		if req.ReviewRejectType != "" && !req.ReviewRejectType.IsKnown() {
+			return fmt.Errorf("unknown review reject type: %s", req.ReviewRejectType)
+		}
+	default:
+		return fmt.Errorf("unknown review answer: %s", req.ReviewAnswer)
+	}
+
+	_, err := call[reqSimulateReview, json.RawMessage](ctx, c,
+		http.MethodPost,
+		fmt.Sprintf("/resources/applicants/%s/status/testCompleted", url.PathEscape(req.ApplicantID)),
+		reqSimulateReview{
+			ReviewAnswer:      req.ReviewAnswer,
+			RejectLabels:      req.RejectLabels,
+			ReviewRejectType:  req.ReviewRejectType,
+			ModerationComment: req.ModerationComment,
+			ClientComment:     req.ClientComment,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("call: %w", err)
+	}
+	return nil
+}
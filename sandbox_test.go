@@ -0,0 +1,72 @@
+package sumsub
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulateReview(t *testing.T) {
+	var bodies []string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/resources/applicants/5b594ade0a975a36c9349e66/status/testCompleted", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		_, _ = w.Write([]byte(`{"ok":1}`))
+	}
+
+	cli := newTestClient(t, handler, WithSandbox())
+	require.NoError(t, cli.SimulateReview(context.Background(), SimulateReviewRequest{
+		ApplicantID:  "5b594ade0a975a36c9349e66",
+		ReviewAnswer: ReviewAnswerGreen,
+	}))
+	require.NoError(t, cli.SimulateReview(context.Background(), SimulateReviewRequest{
+		ApplicantID:       "5b594ade0a975a36c9349e66",
+		ReviewAnswer:      ReviewAnswerRed,
+		RejectLabels:      []RejectLabel{RejectLabelForgery},
+		ReviewRejectType:  ReviewRejectTypeFinal,
+		ModerationComment: "forged passport",
+	}))
+	require.Len(t, bodies, 2)
+	assert.JSONEq(t, `{"reviewAnswer":"GREEN"}`, bodies[0])
+	assert.JSONEq(t, `{"reviewAnswer":"RED","rejectLabels":["FORGERY"],"reviewRejectType":"FINAL","moderationComment":"forged passport"}`, bodies[1])
+
+	for _, req := range []SimulateReviewRequest{
+		{ReviewAnswer: "YELLOW"},
+		{ReviewAnswer: ReviewAnswerRed},
+		{ReviewAnswer: ReviewAnswerGreen, RejectLabels: []RejectLabel{RejectLabelForgery}},
+		{ReviewAnswer: ReviewAnswerRed, RejectLabels: []RejectLabel{RejectLabelForgery}, ReviewRejectType: "LATER"},
+	} {
+		req.ApplicantID = "5b594ade0a975a36c9349e66"
+		assert.Error(t, cli.SimulateReview(context.Background(), req))
+	}
+
+	prod := newTestClient(t, handler)
+	assert.Equal(t, ErrNotSandbox, prod.SimulateReview(context.Background(), SimulateReviewRequest{
+		ApplicantID:  "5b594ade0a975a36c9349e66",
+		ReviewAnswer: ReviewAnswerGreen,
+	}))
+
+	// production token is refused even with WithSandbox
+	srv := httptest.NewTLSServer(http.HandlerFunc(handler))
+	defer srv.Close()
+	for _, cli := range []*Client{
+		NewClient("prd:token", NewHMACSigner("secret"), WithHost(srv.Listener.Addr().String()), WithHTTPClient(srv.Client()), WithSandbox()),
+		NewClient("", nil, WithHost(srv.Listener.Addr().String()), WithHTTPClient(srv.Client()), WithSandbox(),
+			WithCredentialsProvider(CredentialsProviderFunc(func(ctx context.Context) (Credentials, error) {
+				return Credentials{AppToken: "prd:token", SecretKey: "secret"}, nil
+			}))),
+	} {
+		assert.ErrorIs(t, cli.SimulateReview(context.Background(), SimulateReviewRequest{
+			ApplicantID:  "5b594ade0a975a36c9349e66",
+			ReviewAnswer: ReviewAnswerGreen,
+		}), ErrNotSandbox)
+	}
+	assert.Len(t, bodies, 2)
+}
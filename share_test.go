@@ -0,0 +1,78 @@
+package sumsub
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateShareToken(t *testing.T) {
+	now := time.Date(2023, 2, 6, 7, 20, 54, 0, time.UTC)
+	cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/resources/accessTokens/-/shareToken", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		if string(body) == `{"applicantId":"forbidden","forClientId":"partner"}` {
+			writeAPIError(w, http.StatusForbidden, 0)
+			return
+		}
+		assert.JSONEq(t, `{"applicantId":"5b594ade0a975a36c9349e66","forClientId":"partner","ttlInSecs":600}`, string(body))
+		_, _ = w.Write([]byte(`{"token":"_act-sbx-share","forClientId":"partner"}`))
+	})
+	cli.now = func() time.Time { return now }
+
+	resp, err := cli.GenerateShareToken(context.Background(), GenerateShareTokenRequest{
+		ApplicantID: "5b594ade0a975a36c9349e66",
+		ForClientID: "partner",
+		TTL:         10 * time.Minute,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, GenerateShareTokenResponse{
+		Token:       "_act-sbx-share",
+		ForClientID: "partner",
+		ExpiresAt:   now.Add(10 * time.Minute),
+	}, resp)
+
+	_, err = cli.GenerateShareToken(context.Background(), GenerateShareTokenRequest{ApplicantID: "forbidden", ForClientID: "partner"})
+	e, ok := AsAPIError(err)
+	require.True(t, ok)
+	assert.Equal(t, http.StatusForbidden, e.Code)
+}
+
+func TestImportApplicant(t *testing.T) {
+	now := time.Date(2023, 2, 6, 7, 20, 54, 0, time.UTC)
+	cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/resources/applicants/-/import", r.URL.Path)
+		switch r.URL.Query().Get("shareToken") {
+		case "valid":
+			_, _ = w.Write([]byte(`{"id":"63e0a2b6","externalUserId":"user-1"}`))
+		case "expired":
+			writeAPIError(w, http.StatusBadRequest, 0)
+		default:
+			t.Errorf("unexpected token: %s", r.URL.RawQuery)
+		}
+	})
+	cli.now = func() time.Time { return now }
+
+	resp, err := cli.ImportApplicant(context.Background(), ImportApplicantRequest{ShareToken: "valid", ExpiresAt: now.Add(time.Second)})
+	require.NoError(t, err)
+	assert.Equal(t, "63e0a2b6", resp.ID)
+	assert.Equal(t, "user-1", resp.ExternalUserID)
+
+	// expiration reported by the API is not guessed from the answer
+	_, err = cli.ImportApplicant(context.Background(), ImportApplicantRequest{ShareToken: "expired"})
+	assert.False(t, errors.Is(err, ErrShareTokenExpired))
+	e, ok := AsAPIError(err)
+	require.True(t, ok)
+	assert.Equal(t, http.StatusBadRequest, e.Code)
+
+	_, err = cli.ImportApplicant(context.Background(), ImportApplicantRequest{ShareToken: "valid", ExpiresAt: now})
+	assert.Equal(t, ErrShareTokenExpired, err)
+}
@@ -0,0 +1,222 @@
+package sumsub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+type (
+	SetApplicantTagsRequest struct {
+		ApplicantID string
+		Tags        []string
+	}
+
+	ModifyApplicantTagsRequest struct {
+		ApplicantID string
+		Tags        []string
+	}
+
+	ModifyApplicantTagsResponse struct {
+		// Tags resulting tags of the applicant
+		Tags []string
+	}
+
+	CreateApplicantNoteRequest struct {
+		ApplicantID string
+		Note        string
+		Tags        []string
+	}
+
+	ApplicantNotesRequest struct {
+		ApplicantID string
+	}
+
+	ApplicantNotesResponse struct {
+		Items []ApplicantNote
+	}
+
+	DeleteApplicantNoteRequest struct {
+		ApplicantID string
+		NoteID      string
+	}
+
+	ApplicantNote struct {
+		ID          string
+		ApplicantID string
+		Note        string
+		Tags        []string
+		CreatedAt   time.Time
+		CreatedBy   string
+	}
+)
+
+type (
+	reqCreateApplicantNote struct {
+		Note string   `json:"note"`
+		Tags []string `json:"tags,omitempty"`
+	}
+
+	respApplicantNote struct {
+		ID          string   `json:"id"`
+		ApplicantID string   `json:"applicantId"`
+		Note        string   `json:"note"`
+		Tags        []string `json:"tags"`
+		CreatedAt   Time     `json:"createdAt"`
+		CreatedBy   string   `json:"createdBy"`
+	}
+
+	reqApplicantNotes struct {
+	}
+
+	respApplicantNotes struct {
+		Items []respApplicantNote `json:"items"`
+	}
+
+	reqDeleteApplicantNote struct {
+	}
+)
+
+func (r respApplicantNote) model() ApplicantNote {
+	return ApplicantNote{
+		ID:          r.ID,
+		ApplicantID: r.ApplicantID,
+		Note:        r.Note,
+		Tags:        r.Tags,
+		CreatedAt:   r.CreatedAt.Time,
+		CreatedBy:   r.CreatedBy,
+	}
+}
+
+// SetApplicantTags Use this method to replace all tags of the applicant, empty tags remove all of them.
+// https://docs.sumsub.com/reference/add-custom-applicant-tags
+func (c *Client) SetApplicantTags(ctx context.Context, req SetApplicantTagsRequest) error {
+	tags := req.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	_, err := call[[]string, json.RawMessage](ctx, c,
+		http.MethodPost,
+		fmt.Sprintf("/resources/applicants/%s/tags", url.PathEscape(req.ApplicantID)),
+		tags,
+	)
+	if err != nil {
+		return fmt.Errorf("call: %w", err)
+	}
+	return nil
+}
+
+// AddApplicantTags adds the tags to the current applicant tags keeping the order, existing tags are skipped.
+// SumSub has no endpoint for this, tags are read with ApplicantData and written with SetApplicantTags,
+// concurrent modifications of the same applicant tags could be lost.
+func (c *Client) AddApplicantTags(ctx context.Context, req ModifyApplicantTagsRequest) (ModifyApplicantTagsResponse, error) {
+	return c.modifyApplicantTags(ctx, req.ApplicantID, func(current []string) []string {
+		return mergeTags(current, req.Tags)
+	})
+}
+
+// RemoveApplicantTags removes the tags from the current applicant tags, see AddApplicantTags for the limitations.
+func (c *Client) RemoveApplicantTags(ctx context.Context, req ModifyApplicantTagsRequest) (ModifyApplicantTagsResponse, error) {
+	return c.modifyApplicantTags(ctx, req.ApplicantID, func(current []string) []string {
+		return subtractTags(current, req.Tags)
+	})
+}
+
+// CreateApplicantNote Use this method to leave a note on the applicant.
+// https://docs.sumsub.com/reference/add-applicant-note
+func (c *Client) CreateApplicantNote(ctx context.Context, req CreateApplicantNoteRequest) (ApplicantNote, error) {
+	if req.Note == "" {
+		return ApplicantNote{}, errors.New("empty note")
+	}
+	resp, err := call[reqCreateApplicantNote, respApplicantNote](ctx, c,
+		http.MethodPost,
+		fmt.Sprintf("/resources/applicants/%s/notes", url.PathEscape(req.ApplicantID)),
+		reqCreateApplicantNote{
+			Note: req.Note,
+			Tags: req.Tags,
+		},
+	)
+	if err != nil {
+		return ApplicantNote{}, fmt.Errorf("call: %w", err)
+	}
+	return resp.model(), nil
+}
+
+// ApplicantNotes Use this method to list the notes of the applicant.
+// https://docs.sumsub.com/reference/get-applicant-notes
+func (c *Client) ApplicantNotes(ctx context.Context, req ApplicantNotesRequest) (ApplicantNotesResponse, error) {
+	resp, err := call[reqApplicantNotes, respApplicantNotes](ctx, c,
+		http.MethodGet,
+		fmt.Sprintf("/resources/applicants/%s/notes", url.PathEscape(req.ApplicantID)),
+		reqApplicantNotes{},
+	)
+	if err != nil {
+		return ApplicantNotesResponse{}, fmt.Errorf("call: %w", err)
+	}
+	return ApplicantNotesResponse{
+		Items: mapSlice(resp.Items, respApplicantNote.model),
+	}, nil
+}
+
+// DeleteApplicantNote Use this method to delete the note of the applicant.
+// https://docs.sumsub.com/reference/delete-applicant-note
+func (c *Client) DeleteApplicantNote(ctx context.Context, req DeleteApplicantNoteRequest) error {
+	_, err := call[reqDeleteApplicantNote, json.RawMessage](ctx, c,
+		http.MethodDelete,
+		fmt.Sprintf("/resources/applicants/%s/notes/%s", url.PathEscape(req.ApplicantID), url.PathEscape(req.NoteID)),
+		reqDeleteApplicantNote{},
+	)
+	if err != nil {
+		return fmt.Errorf("call: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) modifyApplicantTags(ctx context.Context, applicantID string, modify func([]string) []string) (ModifyApplicantTagsResponse, error) {
+	if applicantID == "" {
+		return ModifyApplicantTagsResponse{}, errors.New("applicant id required")
+	}
+	data, err := c.ApplicantData(ctx, ApplicantDataRequest{ApplicantID: applicantID})
+	if err != nil {
+		return ModifyApplicantTagsResponse{}, fmt.Errorf("applicant data: %w", err)
+	}
+	tags := modify(data.Tags)
+	if err = c.SetApplicantTags(ctx, SetApplicantTagsRequest{ApplicantID: applicantID, Tags: tags}); err != nil {
+		return ModifyApplicantTagsResponse{}, fmt.Errorf("set tags: %w", err)
+	}
+	return ModifyApplicantTagsResponse{Tags: tags}, nil
+}
+
+func mergeTags(current, add []string) []string {
+	res := append([]string{}, current...)
+	seen := make(map[string]struct{}, len(current)+len(add))
+	for _, t := range current {
+		seen[t] = struct{}{}
+	}
+	for _, t := range add {
+		if _, ok := seen[t]; ok {
+			continue
+		}
+		seen[t] = struct{}{}
+		res = append(res, t)
+	}
+	return res
+}
+
+func subtractTags(current, remove []string) []string {
+	drop := make(map[string]struct{}, len(remove))
+	for _, t := range remove {
+		drop[t] = struct{}{}
+	}
+	res := []string{}
+	for _, t := range current {
+		if _, ok := drop[t]; !ok {
+			res = append(res, t)
+		}
+	}
+	return res
+}
@@ -0,0 +1,88 @@
+package sumsub
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplicantTags(t *testing.T) {
+	var set []string
+	cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/resources/applicants/5b594ade0a975a36c9349e66/one":
+			_, _ = w.Write([]byte(`{"id":"5b594ade0a975a36c9349e66","tags":["VIP","manual-review"]}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/resources/applicants/5b594ade0a975a36c9349e66/tags":
+			body, _ := io.ReadAll(r.Body)
+			set = append(set, string(body))
+			_, _ = w.Write([]byte(`{"ok":1}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	require.NoError(t, cli.SetApplicantTags(context.Background(), SetApplicantTagsRequest{ApplicantID: "5b594ade0a975a36c9349e66"}))
+
+	resp, err := cli.AddApplicantTags(context.Background(), ModifyApplicantTagsRequest{
+		ApplicantID: "5b594ade0a975a36c9349e66",
+		Tags:        []string{"high-risk", "VIP"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"VIP", "manual-review", "high-risk"}, resp.Tags)
+
+	resp, err = cli.RemoveApplicantTags(context.Background(), ModifyApplicantTagsRequest{
+		ApplicantID: "5b594ade0a975a36c9349e66",
+		Tags:        []string{"VIP", "manual-review"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{}, resp.Tags)
+
+	assert.Equal(t, []string{`[]`, `["VIP","manual-review","high-risk"]`, `[]`}, set)
+}
+
+func TestApplicantNotes(t *testing.T) {
+	const note = `{"id":"63e0a2b6","applicantId":"5b594ade0a975a36c9349e66","note":"documents checked manually","tags":["manual-review"],"createdAt":"2023-02-06 07:20:54","createdBy":"officer@example.com"}`
+	cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/resources/applicants/5b594ade0a975a36c9349e66/notes":
+			body, _ := io.ReadAll(r.Body)
+			assert.JSONEq(t, `{"note":"documents checked manually","tags":["manual-review"]}`, string(body))
+			_, _ = w.Write([]byte(note))
+		case r.Method == http.MethodGet && r.URL.Path == "/resources/applicants/5b594ade0a975a36c9349e66/notes":
+			_, _ = w.Write([]byte(`{"items":[` + note + `]}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/resources/applicants/5b594ade0a975a36c9349e66/notes/63e0a2b6":
+			_, _ = w.Write([]byte(`{"ok":1}`))
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	n, err := cli.CreateApplicantNote(context.Background(), CreateApplicantNoteRequest{
+		ApplicantID: "5b594ade0a975a36c9349e66",
+		Note:        "documents checked manually",
+		Tags:        []string{"manual-review"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ApplicantNote{
+		ID:          "63e0a2b6",
+		ApplicantID: "5b594ade0a975a36c9349e66",
+		Note:        "documents checked manually",
+		Tags:        []string{"manual-review"},
+		CreatedAt:   time.Date(2023, 2, 6, 7, 20, 54, 0, time.UTC),
+		CreatedBy:   "officer@example.com",
+	}, n)
+
+	notes, err := cli.ApplicantNotes(context.Background(), ApplicantNotesRequest{ApplicantID: "5b594ade0a975a36c9349e66"})
+	require.NoError(t, err)
+	assert.Equal(t, []ApplicantNote{n}, notes.Items)
+
+	require.NoError(t, cli.DeleteApplicantNote(context.Background(), DeleteApplicantNoteRequest{ApplicantID: "5b594ade0a975a36c9349e66", NoteID: "63e0a2b6"}))
+
+	_, err = cli.CreateApplicantNote(context.Background(), CreateApplicantNoteRequest{ApplicantID: "5b594ade0a975a36c9349e66"})
+	assert.EqualError(t, err, "empty note")
+}
@@ -0,0 +1,120 @@
+package sumsub
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Time timestamp in one of the formats SumSub uses across endpoints and webhooks:
+// date only, space separated date and time with optional fraction and offset, RFC 3339 and epoch milliseconds
+// (as number or string). Values are normalized to UTC, null and empty string decode to zero time.
+type Time struct {
+	time.Time
+	// DateOnly the value has no time part, it is marshaled as a date.
+	DateOnly bool
+}
+
+const (
+	timeLayoutDate     = "2006-01-02"
+	timeLayoutDateTime = "2006-01-02 15:04:05"
+)
+
+// timeLayouts layouts with optional fraction (parsed by time.Parse even if not in the layout).
+var timeLayouts = []string{
+	timeLayoutDateTime,
+	"2006-01-02 15:04:05-0700",
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04:05 -0700",
+	time.RFC3339,
+	"2006-01-02T15:04:05-0700",
+	"2006-01-02T15:04:05",
+}
+
+// NewDate returns the Time marshaled as a date, e.g. date of birth.
+func NewDate(t time.Time) Time {
+	return Time{Time: t, DateOnly: true}
+}
+
+// ParseTime parses the value in any of the supported formats.
+func ParseTime(s string) (Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Time{}, nil
+	}
+	if isDigits(s) && len(s) > len("20060102") {
+		ms, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return Time{}, fmt.Errorf("parse time: %w", err)
+		}
+		return Time{Time: time.UnixMilli(ms).UTC()}, nil
+	}
+	if t, err := time.Parse(timeLayoutDate, s); err == nil {
+		return Time{Time: t, DateOnly: true}, nil
+	}
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return Time{Time: t.UTC()}, nil
+		}
+	}
+	return Time{}, fmt.Errorf("parse time: undefined layout: %s", s)
+}
+
+func (t *Time) UnmarshalJSON(b []byte) error {
+	b = bytes.TrimSpace(b)
+	switch {
+	case len(b) == 0 || string(b) == "null":
+		*t = Time{}
+		return nil
+	case b[0] == '"':
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return fmt.Errorf("parse time: %w", err)
+		}
+		v, err := ParseTime(s)
+		if err != nil {
+			return err
+		}
+		*t = v
+		return nil
+	default:
+		// epoch milliseconds
+		ms, err := strconv.ParseInt(string(b), 10, 64)
+		if err != nil {
+			return fmt.Errorf("parse time: invalid format: %s", b)
+		}
+		*t = Time{Time: time.UnixMilli(ms).UTC()}
+		return nil
+	}
+}
+
+// MarshalJSON formats the time as SumSub expects in requests: date for DateOnly, date and time in UTC otherwise.
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.String())
+}
+
+// String returns the value in the SumSub request format, empty string for zero time.
+func (t Time) String() string {
+	if t.IsZero() {
+		return ""
+	}
+	if t.DateOnly {
+		return t.Time.Format(timeLayoutDate)
+	}
+	return t.UTC().Format(timeLayoutDateTime)
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,60 @@
+package sumsub
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeUnmarshalJSON(t *testing.T) {
+	cases := []struct {
+		in       string
+		want     time.Time
+		dateOnly bool
+	}{
+		{in: `null`},
+		{in: `""`},
+		{in: `"2020-06-24"`, want: time.Date(2020, 6, 24, 0, 0, 0, 0, time.UTC), dateOnly: true},
+		{in: `"2020-06-24 05:05:14"`, want: time.Date(2020, 6, 24, 5, 5, 14, 0, time.UTC)},
+		{in: `"2020-06-24 05:05:14+0000"`, want: time.Date(2020, 6, 24, 5, 5, 14, 0, time.UTC)},
+		{in: `"2020-06-24 07:05:14+0200"`, want: time.Date(2020, 6, 24, 5, 5, 14, 0, time.UTC)},
+		{in: `"2020-06-24 07:05:14+02:00"`, want: time.Date(2020, 6, 24, 5, 5, 14, 0, time.UTC)},
+		{in: `"2020-02-21 13:23:19.321"`, want: time.Date(2020, 2, 21, 13, 23, 19, 321000000, time.UTC)},
+		{in: `"2020-02-21 13:23:19.321+0100"`, want: time.Date(2020, 2, 21, 12, 23, 19, 321000000, time.UTC)},
+		{in: `"2020-06-24T05:05:14Z"`, want: time.Date(2020, 6, 24, 5, 5, 14, 0, time.UTC)},
+		{in: `"2020-06-24T08:05:14.123456+03:00"`, want: time.Date(2020, 6, 24, 5, 5, 14, 123456000, time.UTC)},
+		{in: `1582291399321`, want: time.Date(2020, 2, 21, 13, 23, 19, 321000000, time.UTC)},
+		{in: `"1582291399321"`, want: time.Date(2020, 2, 21, 13, 23, 19, 321000000, time.UTC)},
+	}
+	for _, c := range cases {
+		t.Run(c.in, func(t *testing.T) {
+			var v Time
+			require.NoError(t, json.Unmarshal([]byte(c.in), &v))
+			assert.True(t, c.want.Equal(v.Time), v.Time.String())
+			assert.Equal(t, c.dateOnly, v.DateOnly)
+			if !v.IsZero() {
+				assert.Equal(t, time.UTC, v.Location())
+			}
+		})
+	}
+
+	var v Time
+	assert.EqualError(t, json.Unmarshal([]byte(`"24.06.2020"`), &v), "parse time: undefined layout: 24.06.2020")
+	assert.EqualError(t, json.Unmarshal([]byte(`true`), &v), "parse time: invalid format: true")
+}
+
+func TestTimeMarshalJSON(t *testing.T) {
+	b, err := json.Marshal(struct {
+		DOB     Time `json:"dob"`
+		Created Time `json:"created"`
+		Empty   Time `json:"empty"`
+	}{
+		DOB:     NewDate(time.Date(1989, 7, 16, 0, 0, 0, 0, time.UTC)),
+		Created: Time{Time: time.Date(2020, 6, 24, 7, 5, 14, 0, time.FixedZone("", 2*60*60))},
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"dob":"1989-07-16","created":"2020-06-24 05:05:14","empty":null}`, string(b))
+}
@@ -0,0 +1,131 @@
+package sumsub
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+)
+
+type (
+	// WaitForReviewOptions zero values are replaced with defaults.
+	WaitForReviewOptions struct {
+		InitialInterval time.Duration // 1s by default
+		MaxInterval     time.Duration // 30s by default
+		Multiplier      float64       // 2 by default
+	}
+
+	// ReviewTimeoutError returned by WaitForReview when ctx is done before the review is completed.
+	ReviewTimeoutError struct {
+		ApplicantID string
+		LastStatus  ApplicantReviewStatusResponse // zero if no status was received
+		Err         error                         // context error
+	}
+)
+
+func (e *ReviewTimeoutError) Error() string {
+	status := e.LastStatus.ReviewStatus
+	if status == "" {
+		status = "unknown"
+	}
+	return fmt.Sprintf("wait for review of %s: last status %s: %s", e.ApplicantID, status, e.Err)
+}
+
+func (e *ReviewTimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// WaitForReview polls ApplicantReviewStatus with exponential backoff until the review is completed or on hold
+// and returns its result (empty ReviewResult for onHold).
+// Transient network errors, 5xx and 429 answers are retried, other errors (e.g. malformed answer) are returned as is.
+// When ctx is done *ReviewTimeoutError is returned.
+func (c *Client) WaitForReview(ctx context.Context, applicantID string, opts WaitForReviewOptions) (ReviewResult, error) {
+	if opts.InitialInterval <= 0 {
+		opts.InitialInterval = time.Second
+	}
+	if opts.MaxInterval <= 0 {
+		opts.MaxInterval = 30 * time.Second
+	}
+	if opts.Multiplier < 1 {
+		opts.Multiplier = 2
+	}
+
+	var last ApplicantReviewStatusResponse
+	interval := opts.InitialInterval
+	for {
+		status, err := c.ApplicantReviewStatus(ctx, ApplicantReviewStatusRequest{ApplicantID: applicantID})
+		if err == nil {
+			last = status
+			if status.ReviewStatus == ReviewStatusCompleted || status.ReviewStatus == ReviewStatusOnHold {
+				return status.ReviewResult, nil
+			}
+		}
+		switch {
+		case ctx.Err() != nil:
+			return ReviewResult{}, &ReviewTimeoutError{ApplicantID: applicantID, LastStatus: last, Err: ctx.Err()}
+		case err != nil && !isRetryable(err):
+			return ReviewResult{}, err
+		}
+
+		t := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ReviewResult{}, &ReviewTimeoutError{ApplicantID: applicantID, LastStatus: last, Err: ctx.Err()}
+		case <-t.C:
+		}
+		interval = time.Duration(float64(interval) * opts.Multiplier)
+		if interval > opts.MaxInterval {
+			interval = opts.MaxInterval
+		}
+	}
+}
+
+// isRetryable reports whether the request could succeed if repeated: transient network errors, 5xx and 429 answers.
+func isRetryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if e, ok := AsAPIError(err); ok {
+		return isRetryableStatus(e.Code)
+	}
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return isRetryableStatus(statusErr.Code)
+	}
+	return isTransientNetError(err)
+}
+
+func isRetryableStatus(code int) bool {
+	return code >= http.StatusInternalServerError || code == http.StatusTooManyRequests
+}
+
+// isTransientNetError timeouts, failed connections and connections closed by the peer.
+// TLS certificate, DNS not found and malformed URL errors are permanent.
+func isTransientNetError(err error) bool {
+	var verifyErr *tls.CertificateVerificationError
+	var authorityErr x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	var invalidErr x509.CertificateInvalidError
+	if errors.As(err, &verifyErr) || errors.As(err, &authorityErr) || errors.As(err, &hostnameErr) || errors.As(err, &invalidErr) {
+		return false
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTemporary
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return opErr.Op == "dial" || opErr.Op == "read" || opErr.Op == "write"
+	}
+	return errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
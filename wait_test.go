@@ -0,0 +1,137 @@
+package sumsub
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitForReview(t *testing.T) {
+	var calls int32
+	cli := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/resources/applicants/app-1/status":
+			switch atomic.AddInt32(&calls, 1) {
+			case 1:
+				_, _ = w.Write([]byte(`{"reviewStatus":"pending"}`))
+			case 2:
+				writeAPIError(w, http.StatusServiceUnavailable, 0)
+			case 3:
+				http.Error(w, "Service Temporarily Unavailable", http.StatusServiceUnavailable)
+			default:
+				_, _ = w.Write([]byte(`{"reviewStatus":"completed","reviewResult":{"reviewAnswer":"RED","rejectLabels":["FORGERY"],"reviewRejectType":"FINAL"}}`))
+			}
+		case "/resources/applicants/app-2/status":
+			_, _ = w.Write([]byte(`{"reviewStatus":"queued"}`))
+		case "/resources/applicants/app-3/status":
+			atomic.AddInt32(&calls, 1)
+			_, _ = w.Write([]byte(`{"reviewStatus":`))
+		default:
+			writeAPIError(w, http.StatusNotFound, 0)
+		}
+	})
+	opts := WaitForReviewOptions{InitialInterval: time.Millisecond, MaxInterval: 5 * time.Millisecond}
+
+	res, err := cli.WaitForReview(context.Background(), "app-1", opts)
+	require.NoError(t, err)
+	assert.Equal(t, ReviewResult{
+		ReviewAnswer:     ReviewAnswerRed,
+		RejectLabels:     []RejectLabel{RejectLabelForgery},
+		ReviewRejectType: ReviewRejectTypeFinal,
+	}, res)
+	assert.Equal(t, int32(4), atomic.LoadInt32(&calls))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = cli.WaitForReview(ctx, "app-2", opts)
+	var te *ReviewTimeoutError
+	require.True(t, errors.As(err, &te))
+	assert.Equal(t, ReviewStatusQueued, te.LastStatus.ReviewStatus)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	_, err = cli.WaitForReview(context.Background(), "missing", opts)
+	e, ok := AsAPIError(err)
+	require.True(t, ok)
+	assert.Equal(t, http.StatusNotFound, e.Code)
+
+	// malformed answer is not retried
+	atomic.StoreInt32(&calls, 0)
+	_, err = cli.WaitForReview(context.Background(), "app-3", opts)
+	require.Error(t, err)
+	assert.False(t, errors.As(err, &te))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestWaitForReview_CompletedWithDoneContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cli := NewClient("token", NewHMACSigner("secret"), WithHTTPClient(&http.Client{
+		Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			cancel() // deadline hits right after the answer
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": {"application/json"}},
+				Body:       io.NopCloser(strings.NewReader(`{"reviewStatus":"completed","reviewResult":{"reviewAnswer":"GREEN"}}`)),
+				Request:    r,
+			}, nil
+		}),
+	}))
+
+	res, err := cli.WaitForReview(ctx, "app-1", WaitForReviewOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, ReviewAnswerGreen, res.ReviewAnswer)
+}
+
+func TestWaitForReview_CertificateError(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request")
+	}))
+	srv.Config.ErrorLog = log.New(io.Discard, "", 0)
+	defer srv.Close()
+	// the server certificate is not trusted by the default client
+	cli := NewClient("token", NewHMACSigner("secret"), WithHost(srv.Listener.Addr().String()), WithHTTPClient(&http.Client{}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err := cli.WaitForReview(ctx, "app-1", WaitForReviewOptions{InitialInterval: time.Millisecond})
+	require.Error(t, err)
+	var te *ReviewTimeoutError
+	assert.False(t, errors.As(err, &te), "certificate error must not be retried until the deadline")
+	var certErr *tls.CertificateVerificationError
+	assert.ErrorAs(t, err, &certErr)
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestIsRetryable(t *testing.T) {
+	assert.True(t, isRetryable(&url.Error{Op: "Get", URL: "https://api.sumsub.com", Err: &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}}))
+	assert.True(t, isRetryable(&url.Error{Op: "Get", URL: "https://api.sumsub.com", Err: io.EOF}))
+	assert.False(t, isRetryable(&url.Error{Op: "Get", URL: "https://api.sumsub.com", Err: x509.UnknownAuthorityError{}}))
+	assert.False(t, isRetryable(&url.Error{Op: "Get", URL: "https://api.sumsub.com", Err: &net.DNSError{Err: "no such host", IsNotFound: true}}))
+	assert.False(t, isRetryable(&url.Error{Op: "Get", URL: ":", Err: errors.New("missing protocol scheme")}))
+	assert.True(t, isRetryable(&StatusError{Code: http.StatusServiceUnavailable}))
+	assert.False(t, isRetryable(&StatusError{Code: http.StatusForbidden}))
+	assert.True(t, isRetryable(&APIError{Code: http.StatusTooManyRequests}))
+	assert.True(t, isRetryable(&APIError{Code: http.StatusBadGateway}))
+	assert.False(t, isRetryable(&APIError{Code: http.StatusBadRequest}))
+	assert.False(t, isRetryable(errors.New("decode: unexpected EOF")))
+	assert.False(t, isRetryable(context.DeadlineExceeded))
+}
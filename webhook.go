@@ -6,13 +6,150 @@ import (
 	"crypto/sha256"
 	"crypto/sha512"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"hash"
 	"io"
 	"net/http"
+	"time"
 )
 
+const (
+	WebhookDigestAlgSHA1   = "HMAC_SHA1_HEX"
+	WebhookDigestAlgSHA256 = "HMAC_SHA256_HEX"
+	WebhookDigestAlgSHA512 = "HMAC_SHA512_HEX"
+)
+
+const (
+	WebhookTypeApplicantCreated             = "applicantCreated"
+	WebhookTypeApplicantPending             = "applicantPending"
+	WebhookTypeApplicantReviewed            = "applicantReviewed"
+	WebhookTypeApplicantOnHold              = "applicantOnHold"
+	WebhookTypeApplicantPrechecked          = "applicantPrechecked"
+	WebhookTypeApplicantReset               = "applicantReset"
+	WebhookTypeApplicantDeleted             = "applicantDeleted"
+	WebhookTypeApplicantActivated           = "applicantActivated"
+	WebhookTypeApplicantDeactivated         = "applicantDeactivated"
+	WebhookTypeApplicantLevelChanged        = "applicantLevelChanged"
+	WebhookTypeApplicantPersonalInfoChanged = "applicantPersonalInfoChanged"
+	WebhookTypeApplicantTagsChanged         = "applicantTagsChanged"
+	WebhookTypeApplicantWorkflowCompleted   = "applicantWorkflowCompleted"
+	WebhookTypeApplicantActionPending       = "applicantActionPending"
+	WebhookTypeApplicantActionReviewed      = "applicantActionReviewed"
+	WebhookTypeApplicantActionOnHold        = "applicantActionOnHold"
+)
+
+type (
+	// Webhook common part of the SumSub webhook payloads
+	// https://docs.sumsub.com/docs/user-verification-webhooks
+	Webhook struct {
+		ApplicantID    string
+		InspectionID   string
+		CorrelationID  string
+		ExternalUserID string
+		LevelName      string
+		Type           string
+		ReviewStatus   ReviewStatus
+		ReviewResult   ReviewResult
+		SandboxMode    bool
+		ClientID       string
+		CreatedAt      time.Time
+	}
+
+	// ApplicantActionWebhook payload of the applicantAction* webhooks
+	// https://docs.sumsub.com/docs/applicant-actions
+	ApplicantActionWebhook struct {
+		Webhook
+		ApplicantActionID         string
+		ExternalApplicantActionID string
+	}
+)
+
+type (
+	webhookPayload struct {
+		ApplicantID               string           `json:"applicantId"`
+		InspectionID              string           `json:"inspectionId"`
+		CorrelationID             string           `json:"correlationId"`
+		ExternalUserID            string           `json:"externalUserId"`
+		LevelName                 string           `json:"levelName"`
+		Type                      string           `json:"type"`
+		ReviewStatus              ReviewStatus     `json:"reviewStatus"`
+		ReviewResult              respReviewResult `json:"reviewResult"`
+		SandboxMode               bool             `json:"sandboxMode"`
+		ClientID                  string           `json:"clientId"`
+		CreatedAtMs               Time             `json:"createdAtMs"`
+		ApplicantActionID         string           `json:"applicantActionId"`
+		ExternalApplicantActionID string           `json:"externalApplicantActionId"`
+	}
+)
+
+// ParseWebhook decodes the webhook payload. Verify the payload with VerifyWebhookDigest or VerifyWebhookRequest before.
+func ParseWebhook(payload []byte) (Webhook, error) {
+	p, err := parseWebhookPayload(payload)
+	if err != nil {
+		return Webhook{}, err
+	}
+	return p.model(), nil
+}
+
+// ParseApplicantActionWebhook decodes the applicantAction* webhook payload.
+func ParseApplicantActionWebhook(payload []byte) (ApplicantActionWebhook, error) {
+	p, err := parseWebhookPayload(payload)
+	if err != nil {
+		return ApplicantActionWebhook{}, err
+	}
+	if p.ApplicantActionID == "" {
+		return ApplicantActionWebhook{}, fmt.Errorf("not an applicant action webhook: %s", p.Type)
+	}
+	return ApplicantActionWebhook{
+		Webhook:                   p.model(),
+		ApplicantActionID:         p.ApplicantActionID,
+		ExternalApplicantActionID: p.ExternalApplicantActionID,
+	}, nil
+}
+
+func parseWebhookPayload(payload []byte) (webhookPayload, error) {
+	var p webhookPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return webhookPayload{}, fmt.Errorf("json: unmarshal: %w", err)
+	}
+	if p.Type == "" {
+		return webhookPayload{}, errors.New("empty webhook type")
+	}
+	return p, nil
+}
+
+func (p webhookPayload) model() Webhook {
+	return Webhook{
+		ApplicantID:    p.ApplicantID,
+		InspectionID:   p.InspectionID,
+		CorrelationID:  p.CorrelationID,
+		ExternalUserID: p.ExternalUserID,
+		LevelName:      p.LevelName,
+		Type:           p.Type,
+		ReviewStatus:   p.ReviewStatus,
+		ReviewResult:   p.ReviewResult.model(),
+		SandboxMode:    p.SandboxMode,
+		ClientID:       p.ClientID,
+		CreatedAt:      p.CreatedAtMs.Time,
+	}
+}
+
+// SignWebhookPayload returns the hex digest SumSub sends in the X-Payload-Digest header for the payload.
+func SignWebhookPayload(payload []byte, secretKey, algo string) (string, error) {
+	if secretKey == "" {
+		return "", errors.New("empty secret key")
+	}
+	hashFunc, err := webhookHashFunc(algo)
+	if err != nil {
+		return "", err
+	}
+	mac := hmac.New(hashFunc, []byte(secretKey))
+	_, _ = mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
 func VerifyWebhookDigest(payload []byte, secretKey, algo, digestHex string) error {
 	if digestHex == "" {
 		return errors.New("empty digest")
@@ -21,16 +158,9 @@ func VerifyWebhookDigest(payload []byte, secretKey, algo, digestHex string) erro
 		return errors.New("empty secret key")
 	}
 
-	var hashFunc func() hash.Hash
-	switch algo {
-	case "HMAC_SHA256_HEX":
-		hashFunc = sha256.New
-	case "HMAC_SHA512_HEX":
-		hashFunc = sha512.New
-	case "HMAC_SHA1_HEX":
-		hashFunc = sha1.New
-	default:
-		return fmt.Errorf("unsupported algo: %s", algo)
+	hashFunc, err := webhookHashFunc(algo)
+	if err != nil {
+		return err
 	}
 
 	mac := hmac.New(hashFunc, []byte(secretKey))
@@ -61,3 +191,42 @@ func VerifyWebhookRequest(r *http.Request, secretKey string) error {
 		r.Header.Get("X-Payload-Digest"),
 	)
 }
+
+// WebhookVerifier verifies webhooks signed with the secret key.
+type WebhookVerifier struct {
+	secretKey string
+}
+
+func NewWebhookVerifier(secretKey string) *WebhookVerifier {
+	return &WebhookVerifier{secretKey: secretKey}
+}
+
+// Verify checks the digest of the payload, see VerifyWebhookDigest.
+func (v *WebhookVerifier) Verify(payload []byte, algo, digestHex string) error {
+	return VerifyWebhookDigest(payload, v.secretKey, algo, digestHex)
+}
+
+// VerifyRequest reads and verifies the request body, returns the payload to be parsed.
+func (v *WebhookVerifier) VerifyRequest(r *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read body: %w", err)
+	}
+	if err = v.Verify(body, r.Header.Get("X-Payload-Digest-Alg"), r.Header.Get("X-Payload-Digest")); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+func webhookHashFunc(algo string) (func() hash.Hash, error) {
+	switch algo {
+	case WebhookDigestAlgSHA256:
+		return sha256.New, nil
+	case WebhookDigestAlgSHA512:
+		return sha512.New, nil
+	case WebhookDigestAlgSHA1:
+		return sha1.New, nil
+	default:
+		return nil, fmt.Errorf("unsupported algo: %s", algo)
+	}
+}
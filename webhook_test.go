@@ -210,3 +210,61 @@ func TestVerifyWebhookRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestSignWebhookPayload(t *testing.T) {
+	digest, err := SignWebhookPayload([]byte("someText"), "SoMe_SeCrEt_KeY", WebhookDigestAlgSHA1)
+	assert.NoError(t, err)
+	assert.Equal(t, "f6e92ffe371718694d46e28436f76589312df8db", digest)
+	assert.NoError(t, VerifyWebhookDigest([]byte("someText"), "SoMe_SeCrEt_KeY", WebhookDigestAlgSHA1, digest))
+
+	_, err = SignWebhookPayload([]byte("someText"), "", WebhookDigestAlgSHA256)
+	assert.EqualError(t, err, "empty secret key")
+
+	_, err = SignWebhookPayload([]byte("someText"), "secret", "HMAC_MD5_HEX")
+	assert.EqualError(t, err, "unsupported algo: HMAC_MD5_HEX")
+}
+
+func TestWebhookVerifier(t *testing.T) {
+	v := NewWebhookVerifier("secret")
+	payload := `{"applicantId":"5b594ade0a975a36c9349e66","type":"applicantReviewed"}`
+	digest, err := SignWebhookPayload([]byte(payload), "secret", WebhookDigestAlgSHA256)
+	assert.NoError(t, err)
+
+	r, _ := http.NewRequest(http.MethodPost, "/webhook", strings.NewReader(payload))
+	r.Header.Set("X-Payload-Digest-Alg", WebhookDigestAlgSHA256)
+	r.Header.Set("X-Payload-Digest", digest)
+	body, err := v.VerifyRequest(r)
+	assert.NoError(t, err)
+	assert.Equal(t, payload, string(body))
+
+	assert.EqualError(t, NewWebhookVerifier("other").Verify([]byte(payload), WebhookDigestAlgSHA256, digest), "digest mismatch")
+}
+
+func TestParseWebhook(t *testing.T) {
+	wh, err := ParseWebhook([]byte(`{
+  "applicantId": "5cb56e8e0a975a35f333cb83",
+  "inspectionId": "5cb56e8e0a975a35f333cb84",
+  "correlationId": "req-a260b669-4f14-4bb5-a4c5-ac0218acb9a4",
+  "externalUserId": "externalUserId",
+  "levelName": "basic-kyc-level",
+  "type": "applicantReviewed",
+  "reviewResult": {
+    "reviewAnswer": "RED",
+    "rejectLabels": ["FORGERY"],
+    "reviewRejectType": "FINAL"
+  },
+  "reviewStatus": "completed",
+  "createdAtMs": "2020-02-21 13:23:19.321"
+}`))
+	assert.NoError(t, err)
+	assert.Equal(t, WebhookTypeApplicantReviewed, wh.Type)
+	assert.Equal(t, "5cb56e8e0a975a35f333cb83", wh.ApplicantID)
+	assert.Equal(t, "externalUserId", wh.ExternalUserID)
+	assert.Equal(t, ReviewStatusCompleted, wh.ReviewStatus)
+	assert.Equal(t, ReviewAnswerRed, wh.ReviewResult.ReviewAnswer)
+	assert.Equal(t, []RejectLabel{RejectLabelForgery}, wh.ReviewResult.RejectLabels)
+	assert.Equal(t, int64(1582291399321), wh.CreatedAt.UnixMilli())
+
+	_, err = ParseWebhook([]byte(`{}`))
+	assert.EqualError(t, err, "empty webhook type")
+}